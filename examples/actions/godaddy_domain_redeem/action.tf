@@ -0,0 +1,12 @@
+provider "godaddy" {
+  customer_id = var.godaddy_customer_id
+}
+
+action "godaddy_domain_redeem" "example" {
+  config {
+    domain       = "example.com"
+    agreed_by    = "203.0.113.10"
+    fee          = 80
+    wait_timeout = "2h"
+  }
+}
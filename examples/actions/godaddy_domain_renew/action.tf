@@ -0,0 +1,7 @@
+action "godaddy_domain_renew" "example" {
+  config {
+    domain    = "example.com"
+    period    = 2
+    max_price = 40
+  }
+}
@@ -0,0 +1,15 @@
+# All recent actions for a domain
+data "godaddy_domain_actions" "all" {
+  domain = "example.com"
+}
+
+# Failed DNSSEC updates
+data "godaddy_domain_actions" "failed_dnssec" {
+  domain = "example.com"
+  type   = "DNSSEC_CREATE"
+  status = "FAILED"
+}
+
+output "dnssec_failures" {
+  value = [for a in data.godaddy_domain_actions.failed_dnssec.actions : "${a.created_at}: ${a.reason_code} ${a.reason_message}"]
+}
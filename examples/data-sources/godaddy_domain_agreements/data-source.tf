@@ -0,0 +1,11 @@
+data "godaddy_domain_agreements" "registration" {
+  tlds    = ["com", "us"]
+  privacy = true
+}
+
+output "agreements" {
+  value = {
+    for agreement in data.godaddy_domain_agreements.registration.agreements :
+    agreement.agreement_key => agreement.url
+  }
+}
@@ -0,0 +1,15 @@
+data "godaddy_domain_availability" "campaign" {
+  domains    = ["summer-sale.com", "summer-sale.net", "summer-sale.shop"]
+  check_type = "FULL"
+}
+
+locals {
+  affordable = [
+    for r in data.godaddy_domain_availability.campaign.results : r.domain
+    if r.available && r.definitive && r.price <= 20
+  ]
+}
+
+output "domains_to_register" {
+  value = local.affordable
+}
@@ -0,0 +1,17 @@
+data "godaddy_domain_suggestions" "product" {
+  query      = "cold brew coffee"
+  tlds       = ["com", "shop", "coffee"]
+  sources    = ["KEYWORD_SPIN", "EXTENSION"]
+  length_max = 20
+  limit      = 25
+  wait_ms    = 2000
+}
+
+# Keep only the suggestions that can actually be registered
+data "godaddy_domain_availability" "product" {
+  domains = data.godaddy_domain_suggestions.product.domains
+}
+
+output "available_names" {
+  value = data.godaddy_domain_availability.product.available_domains
+}
@@ -0,0 +1,17 @@
+# Active .com domains expiring in 2026
+data "godaddy_domains" "expiring" {
+  statuses       = ["ACTIVE"]
+  tlds           = ["com"]
+  expires_after  = "2026-01-01T00:00:00Z"
+  expires_before = "2027-01-01T00:00:00Z"
+}
+
+# Apply a standard SPF record to each of them
+resource "godaddy_dns_record" "spf" {
+  for_each = toset(data.godaddy_domains.expiring.names)
+
+  domain = each.value
+  type   = "TXT"
+  name   = "@"
+  data   = "v=spf1 -all"
+}
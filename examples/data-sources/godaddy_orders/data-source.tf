@@ -0,0 +1,8 @@
+data "godaddy_orders" "this_year" {
+  created_after = "2026-01-01T00:00:00Z"
+  limit         = 200
+}
+
+output "spend_this_year" {
+  value = sum([for order in data.godaddy_orders.this_year.orders : order.total])
+}
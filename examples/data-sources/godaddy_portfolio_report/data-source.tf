@@ -0,0 +1,15 @@
+data "godaddy_portfolio_report" "production" {
+  name_regex = "^(example|example-shop)\\."
+
+  require_locked              = true
+  require_transfer_protection = true
+  require_dnssec              = true
+  approved_nameservers        = ["ns1.example.net", "ns2.example.net"]
+}
+
+check "portfolio_compliance" {
+  assert {
+    condition     = data.godaddy_portfolio_report.production.compliant
+    error_message = "Non-compliant domains: ${join(", ", data.godaddy_portfolio_report.production.non_compliant_domains)}"
+  }
+}
@@ -0,0 +1,14 @@
+data "godaddy_renewal_forecast" "next_year" {
+  months = 12
+}
+
+output "renewal_bill" {
+  value = {
+    for month in data.godaddy_renewal_forecast.next_year.forecast :
+    month.month => "${month.auto_renew_count} domain(s), ${month.auto_renew_cost} ${data.godaddy_renewal_forecast.next_year.currency}"
+  }
+}
+
+output "domains_at_risk" {
+  value = data.godaddy_renewal_forecast.next_year.at_risk[*].domain
+}
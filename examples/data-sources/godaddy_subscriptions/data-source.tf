@@ -0,0 +1,14 @@
+data "godaddy_subscriptions" "domains" {
+  product_group_keys     = ["domains"]
+  include_renewal_prices = true
+}
+
+output "renewals" {
+  value = [
+    for subscription in data.godaddy_subscriptions.domains.subscriptions : {
+      domain   = subscription.domain
+      renew_at = subscription.renew_at
+      price    = subscription.renewal_price
+    }
+  ]
+}
@@ -0,0 +1,8 @@
+data "godaddy_tld_purchase_schema" "us" {
+  tld = "us"
+}
+
+# Fields a .us registration must provide, such as usNexus
+output "us_required_fields" {
+  value = data.godaddy_tld_purchase_schema.us.required_fields
+}
@@ -0,0 +1,7 @@
+data "godaddy_tlds" "country_code" {
+  type = "COUNTRY_CODE"
+}
+
+output "country_code_tlds" {
+  value = data.godaddy_tlds.country_code.names
+}
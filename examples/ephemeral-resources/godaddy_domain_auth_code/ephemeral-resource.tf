@@ -0,0 +1,13 @@
+ephemeral "godaddy_domain_auth_code" "example" {
+  domain = "example.com"
+  unlock = true
+}
+
+# Hand the code to the gaining registrar through a write-only argument so it
+# is never stored in state. "newregistrar_domain_transfer" stands for the
+# transfer resource of the registrar the domain is moving to.
+resource "newregistrar_domain_transfer" "example" {
+  domain               = "example.com"
+  auth_code_wo         = ephemeral.godaddy_domain_auth_code.example.auth_code
+  auth_code_wo_version = 1
+}
@@ -0,0 +1,22 @@
+resource "godaddy_domain_contacts" "example" {
+  domain = "example.com"
+
+  registrant = {
+    name_first   = "Jane"
+    name_last    = "Smith"
+    organization = "Example Corp"
+    email        = "domains@example.com"
+    phone        = "+1.5555551234"
+    address1     = "123 Main St"
+    city         = "Anytown"
+    state        = "CA"
+    postal_code  = "12345"
+    country      = "US"
+  }
+
+  # Keep the admin and technical contacts identical to the registrant
+  copy_from = {
+    admin = "registrant"
+    tech  = "registrant"
+  }
+}
@@ -0,0 +1,16 @@
+provider "godaddy" {
+  customer_id = var.godaddy_customer_id
+}
+
+resource "godaddy_domain_dnssec" "example" {
+  domain = "example.com"
+
+  records = [
+    {
+      key_tag     = 2371
+      algorithm   = 13
+      digest_type = 2
+      digest      = "1F987CC6583E92DF0890718C42F3C42F9DBD7C0E0D6C1F4DB8B4D2C1A3E2F7A1"
+    }
+  ]
+}
@@ -0,0 +1,19 @@
+provider "godaddy" {
+  customer_id = var.godaddy_customer_id
+}
+
+# Permanently redirect a parked domain to the main site
+resource "godaddy_domain_forwarding" "parked" {
+  fqdn = "example.net"
+  url  = "https://www.example.com"
+}
+
+# Show another site under the domain's own address
+resource "godaddy_domain_forwarding" "masked" {
+  fqdn             = "promo.example.com"
+  url              = "https://campaigns.example.org/spring"
+  type             = "MASKED"
+  mask_title       = "Spring Sale"
+  mask_description = "Our spring sale"
+  mask_keywords    = "sale, spring"
+}
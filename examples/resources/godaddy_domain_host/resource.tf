@@ -0,0 +1,25 @@
+provider "godaddy" {
+  customer_id = var.godaddy_customer_id
+}
+
+resource "godaddy_domain_host" "ns1" {
+  domain   = "example.com"
+  hostname = "ns1.example.com"
+  ipv4     = ["192.0.2.53"]
+  ipv6     = ["2001:db8::53"]
+}
+
+resource "godaddy_domain_host" "ns2" {
+  domain   = "example.com"
+  hostname = "ns2.example.com"
+  ipv4     = ["198.51.100.53"]
+}
+
+# Delegate the domain to the vanity nameservers once the glue exists
+resource "godaddy_domain" "example" {
+  domain = "example.com"
+  nameservers = [
+    godaddy_domain_host.ns1.hostname,
+    godaddy_domain_host.ns2.hostname,
+  ]
+}
@@ -0,0 +1,21 @@
+provider "godaddy" {
+  customer_id = var.godaddy_customer_id
+}
+
+variable "transfer_auth_code" {
+  type      = string
+  sensitive = true
+}
+
+resource "godaddy_domain_transfer_in" "example" {
+  domain    = "example.com"
+  auth_code = var.transfer_auth_code
+
+  agreed_by      = "203.0.113.10"
+  agreement_keys = ["DNTA"]
+  price          = 11.99
+  currency       = "USD"
+
+  wait_for_completion = true
+  wait_timeout        = "168h"
+}
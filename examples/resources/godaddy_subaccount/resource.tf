@@ -0,0 +1,21 @@
+variable "customer_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "godaddy_subaccount" "customer" {
+  name_first  = "Jane"
+  name_last   = "Doe"
+  email       = "jane.doe@example.com"
+  market_id   = "en-GB"
+  external_id = 1042
+
+  password         = var.customer_password
+  password_version = 1
+
+  audit_client_ip = "203.0.113.10"
+}
+
+output "customer_shopper_id" {
+  value = godaddy_subaccount.customer.shopper_id
+}
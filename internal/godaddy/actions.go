@@ -0,0 +1,170 @@
+package godaddy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Domain action types reported by the v2 actions endpoints
+const (
+	ActionDNSSECCreate            = "DNSSEC_CREATE"
+	ActionDNSSECDelete            = "DNSSEC_DELETE"
+	ActionDomainUpdate            = "DOMAIN_UPDATE"
+	ActionDomainUpdateContacts    = "DOMAIN_UPDATE_CONTACTS"
+	ActionDomainUpdateNameServers = "DOMAIN_UPDATE_NAME_SERVERS"
+	ActionHostCreate              = "HOST_CREATE"
+	ActionHostDelete              = "HOST_DELETE"
+	ActionHostUpdate              = "HOST_UPDATE"
+	ActionRedeem                  = "REDEEM"
+	ActionRenew                   = "RENEW"
+	ActionTransferOutAccept       = "TRANSFER_OUT_ACCEPT"
+	ActionTransferOutReject       = "TRANSFER_OUT_REJECT"
+)
+
+// Domain action statuses
+const (
+	ActionStatusAccepted  = "ACCEPTED"
+	ActionStatusAwaiting  = "AWAITING"
+	ActionStatusCancelled = "CANCELLED"
+	ActionStatusFailed    = "FAILED"
+	ActionStatusPending   = "PENDING"
+	ActionStatusSuccess   = "SUCCESS"
+)
+
+// DomainActionReason explains why an action failed
+type DomainActionReason struct {
+	Code    string   `json:"code"`
+	Message string   `json:"message"`
+	Fields  []string `json:"fields,omitempty"`
+}
+
+// DomainAction is an asynchronous operation on a domain
+type DomainAction struct {
+	Type        string              `json:"type"`
+	Origin      string              `json:"origin,omitempty"`
+	Status      string              `json:"status"`
+	RequestID   string              `json:"requestId,omitempty"`
+	CreatedAt   *time.Time          `json:"createdAt,omitempty"`
+	StartedAt   *time.Time          `json:"startedAt,omitempty"`
+	CompletedAt *time.Time          `json:"completedAt,omitempty"`
+	ModifiedAt  *time.Time          `json:"modifiedAt,omitempty"`
+	Reason      *DomainActionReason `json:"reason,omitempty"`
+}
+
+// IsFinished reports whether the action has reached a final status
+func (a DomainAction) IsFinished() bool {
+	switch a.Status {
+	case ActionStatusSuccess, ActionStatusFailed, ActionStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// DomainActionError is returned when an action ends without succeeding
+type DomainActionError struct {
+	Domain string
+	Action DomainAction
+}
+
+func (e *DomainActionError) Error() string {
+	msg := fmt.Sprintf("%s action on %s ended with status %s", e.Action.Type, e.Domain, e.Action.Status)
+	if reason := e.Action.Reason; reason != nil {
+		msg += fmt.Sprintf(": %s", reason.Code)
+		if reason.Message != "" {
+			msg += fmt.Sprintf(" (%s)", reason.Message)
+		}
+		if len(reason.Fields) > 0 {
+			msg += fmt.Sprintf(" [fields: %s]", strings.Join(reason.Fields, ", "))
+		}
+	}
+	return msg
+}
+
+func (c *Client) ListDomainActions(ctx context.Context, domain string) ([]DomainAction, error) {
+	path, err := c.customerPath("/domains/%s/actions", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions for domain %s: %w", domain, err)
+	}
+
+	var result []DomainAction
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to list actions for domain %s: %w", domain, err)
+	}
+	return result, nil
+}
+
+// GetDomainAction returns the most recent action of the given type
+func (c *Client) GetDomainAction(ctx context.Context, domain, actionType string) (*DomainAction, error) {
+	path, err := c.customerPath("/domains/%s/actions/%s", domain, actionType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s action for domain %s: %w", actionType, domain, err)
+	}
+
+	var result DomainAction
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get %s action for domain %s: %w", actionType, domain, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) CancelDomainAction(ctx context.Context, domain, actionType string) error {
+	path, err := c.customerPath("/domains/%s/actions/%s", domain, actionType)
+	if err != nil {
+		return fmt.Errorf("failed to cancel %s action for domain %s: %w", actionType, domain, err)
+	}
+
+	if err := c.Delete(ctx, path); err != nil {
+		return fmt.Errorf("failed to cancel %s action for domain %s: %w", actionType, domain, err)
+	}
+	return nil
+}
+
+// actionStartGrace is how long WaitForDomainAction waits for an action
+// started at or after since to show up. Changes that GoDaddy applies
+// synchronously never create one.
+const actionStartGrace = time.Minute
+
+// actionClockSkew allows for differences between the local clock and
+// GoDaddy's when matching actions to the change that started them.
+const actionClockSkew = 30 * time.Second
+
+// WaitForDomainAction waits until the action of the given type started at or
+// after since has finished. It returns a *DomainActionError when the action
+// fails or is cancelled. If no such action appears within a minute the change
+// is assumed to have been applied synchronously.
+func (c *Client) WaitForDomainAction(ctx context.Context, domain, actionType string, since time.Time, opts WaitOptions) (*DomainAction, error) {
+	var last *DomainAction
+	err := WaitFor(ctx, opts, func(ctx context.Context) (bool, error) {
+		action, err := c.GetDomainAction(ctx, domain, actionType)
+		if err != nil {
+			if !strings.Contains(err.Error(), "404") {
+				return false, err
+			}
+			action = nil
+		}
+
+		if action == nil || action.CreatedAt == nil || action.CreatedAt.Before(since.Add(-actionClockSkew)) {
+			// The action for this change has not been recorded (yet). One
+			// without a creation time can't be told apart from an older one.
+			return time.Since(since) > actionStartGrace, nil
+		}
+
+		last = action
+		if !action.IsFinished() {
+			return false, nil
+		}
+		if action.Status != ActionStatusSuccess {
+			return false, &DomainActionError{Domain: domain, Action: *action}
+		}
+		return true, nil
+	})
+
+	var actionErr *DomainActionError
+	if err != nil && !errors.As(err, &actionErr) {
+		err = fmt.Errorf("waiting for %s action on domain %s: %w", actionType, domain, err)
+	}
+	return last, err
+}
@@ -0,0 +1,102 @@
+package godaddy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	calls := 0
+	err := WaitFor(context.Background(), WaitOptions{Timeout: time.Second, Interval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("WaitFor() error = %v after %d calls, want success after 3", err, calls)
+	}
+
+	err = WaitFor(context.Background(), WaitOptions{Timeout: 5 * time.Millisecond, Interval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("WaitFor() error = %v, want a timeout", err)
+	}
+}
+
+func TestClient_WaitForDomainAction(t *testing.T) {
+	since := time.Now().UTC()
+	created := since.Add(time.Second).Format(time.RFC3339)
+
+	tests := []struct {
+		name      string
+		responses []string
+		since     time.Time
+		wantErr   bool
+	}{
+		{
+			name: "success after pending",
+			responses: []string{
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"PENDING","createdAt":"` + created + `"}`,
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"SUCCESS","createdAt":"` + created + `"}`,
+			},
+			since: since,
+		},
+		{
+			name: "failure with reason",
+			responses: []string{
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"FAILED","createdAt":"` + created + `","reason":{"code":"INVALID_NAMESERVER","message":"ns1.example.net does not resolve"}}`,
+			},
+			since:   since,
+			wantErr: true,
+		},
+		{
+			name: "no creation time",
+			responses: []string{
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"SUCCESS"}`,
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"FAILED","createdAt":"` + created + `","reason":{"code":"INVALID_NAMESERVER","message":"ns1.example.net does not resolve"}}`,
+			},
+			since:   since,
+			wantErr: true,
+		},
+		{
+			name: "no action after grace period",
+			responses: []string{
+				`{"type":"DOMAIN_UPDATE_NAME_SERVERS","status":"SUCCESS","createdAt":"2020-01-01T00:00:00Z"}`,
+			},
+			since: since.Add(-2 * actionStartGrace),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/customers/cust-1/domains/example.com/actions/DOMAIN_UPDATE_NAME_SERVERS" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Write([]byte(tt.responses[min(call, len(tt.responses)-1)]))
+				call++
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+			_, err := client.WaitForDomainAction(context.Background(), "example.com", ActionDomainUpdateNameServers,
+				tt.since, WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitForDomainAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				var actionErr *DomainActionError
+				if !errors.As(err, &actionErr) || !strings.Contains(err.Error(), "does not resolve") {
+					t.Errorf("WaitForDomainAction() error = %v, want a DomainActionError with the reason", err)
+				}
+			}
+		})
+	}
+}
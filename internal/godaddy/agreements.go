@@ -0,0 +1,44 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// LegalAgreement is a legal agreement the customer must accept before
+// purchasing or transferring domains
+type LegalAgreement struct {
+	AgreementKey string `json:"agreementKey"`
+	Title        string `json:"title"`
+	URL          string `json:"url,omitempty"`
+	Content      string `json:"content"`
+}
+
+// AgreementOptions selects the agreements that apply to a purchase
+type AgreementOptions struct {
+	// Privacy includes the agreements for domain privacy
+	Privacy bool
+	// ForTransfer returns the agreements for transferring the domains in
+	// instead of registering them
+	ForTransfer bool
+}
+
+// GetLegalAgreements returns the legal agreements required to purchase
+// domains with the given TLDs. The keys go into DomainConsent.AgreementKeys.
+func (c *Client) GetLegalAgreements(ctx context.Context, tlds []string, opts AgreementOptions) ([]LegalAgreement, error) {
+	params := url.Values{}
+	params.Set("tlds", strings.Join(tlds, ","))
+	params.Set("privacy", strconv.FormatBool(opts.Privacy))
+	if opts.ForTransfer {
+		params.Set("forTransfer", "true")
+	}
+
+	var result []LegalAgreement
+	if err := c.Get(ctx, "/v1/domains/agreements?"+params.Encode(), &result); err != nil {
+		return nil, fmt.Errorf("failed to get legal agreements for %s: %w", strings.Join(tlds, ", "), err)
+	}
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package godaddy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetLegalAgreements(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/agreements" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("tlds"); got != "com,us" {
+			t.Errorf("tlds = %q, want %q", got, "com,us")
+		}
+		if got := query.Get("privacy"); got != "true" {
+			t.Errorf("privacy = %q, want %q", got, "true")
+		}
+		if query.Has("forTransfer") {
+			t.Error("forTransfer should not be sent when unset")
+		}
+
+		w.Write([]byte(`[
+			{"agreementKey":"DNRA","title":"Domain Name Registration Agreement","url":"https://www.godaddy.com/agreements/dnra","content":"..."},
+			{"agreementKey":"DNPA","title":"Domains by Proxy Agreement","content":"..."}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	agreements, err := client.GetLegalAgreements(context.Background(), []string{"com", "us"}, AgreementOptions{Privacy: true})
+	if err != nil {
+		t.Fatalf("GetLegalAgreements() error = %v", err)
+	}
+	if len(agreements) != 2 || agreements[0].AgreementKey != "DNRA" || agreements[1].URL != "" {
+		t.Errorf("GetLegalAgreements() = %+v", agreements)
+	}
+}
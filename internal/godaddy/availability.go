@@ -0,0 +1,83 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Availability check types. FAST may answer from cached registry data and is
+// then not definitive; FULL always asks the registry.
+const (
+	CheckTypeFast = "FAST"
+	CheckTypeFull = "FULL"
+)
+
+// maxAvailabilityBatch is the largest number of domains GoDaddy accepts in
+// one bulk availability check
+const maxAvailabilityBatch = 500
+
+// AvailabilityOptions changes how availability is checked
+type AvailabilityOptions struct {
+	// CheckType is CheckTypeFast or CheckTypeFull. GoDaddy defaults to FAST.
+	CheckType string
+	// ForTransfer checks whether the domain can be transferred in instead of
+	// registered. It is only supported for single domain checks.
+	ForTransfer bool
+}
+
+func (o AvailabilityOptions) query() url.Values {
+	query := url.Values{}
+	if o.CheckType != "" {
+		query.Set("checkType", o.CheckType)
+	}
+	if o.ForTransfer {
+		query.Set("forTransfer", strconv.FormatBool(o.ForTransfer))
+	}
+	return query
+}
+
+// DomainAvailabilityError is reported for a domain that could not be checked
+type DomainAvailabilityError struct {
+	Domain  string `json:"domain"`
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+	Status  int    `json:"status,omitempty"`
+}
+
+// DomainAvailabilityBulk is the result of a bulk availability check
+type DomainAvailabilityBulk struct {
+	Domains []DomainAvailability      `json:"domains"`
+	Errors  []DomainAvailabilityError `json:"errors,omitempty"`
+}
+
+// CheckDomainsAvailability checks many domains at once, in batches of up to
+// 500. Domains that could not be checked are listed in Errors instead of
+// failing the whole check.
+func (c *Client) CheckDomainsAvailability(ctx context.Context, domains []string, opts AvailabilityOptions) (*DomainAvailabilityBulk, error) {
+	if opts.ForTransfer {
+		return nil, fmt.Errorf("failed to check domain availability: transfer checks are not supported in bulk")
+	}
+
+	path := "/v1/domains/available"
+	if query := opts.query(); len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	result := &DomainAvailabilityBulk{}
+	for start := 0; start < len(domains); start += maxAvailabilityBatch {
+		end := start + maxAvailabilityBatch
+		if end > len(domains) {
+			end = len(domains)
+		}
+
+		var batch DomainAvailabilityBulk
+		if err := c.Post(ctx, path, domains[start:end], &batch); err != nil {
+			return nil, fmt.Errorf("failed to check domain availability: %w", err)
+		}
+		result.Domains = append(result.Domains, batch.Domains...)
+		result.Errors = append(result.Errors, batch.Errors...)
+	}
+	return result, nil
+}
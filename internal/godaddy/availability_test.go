@@ -0,0 +1,86 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CheckDomainAvailability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("domain") != "bücher.example&x=1" {
+			t.Errorf("domain = %q, want it passed through unchanged", query.Get("domain"))
+		}
+		if query.Get("checkType") != CheckTypeFull || query.Get("forTransfer") != "true" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"domain":"bücher.example","available":true,"definitive":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	availability, err := client.CheckDomainAvailability(context.Background(), "bücher.example&x=1",
+		AvailabilityOptions{CheckType: CheckTypeFull, ForTransfer: true})
+	if err != nil {
+		t.Fatalf("CheckDomainAvailability() error = %v", err)
+	}
+	if !availability.Available || !availability.Definitive {
+		t.Errorf("CheckDomainAvailability() = %+v", availability)
+	}
+}
+
+func TestClient_CheckDomainsAvailability(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/domains/available" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("checkType") != CheckTypeFast {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		var domains []string
+		if err := json.NewDecoder(r.Body).Decode(&domains); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		batches = append(batches, len(domains))
+
+		result := DomainAvailabilityBulk{}
+		for _, domain := range domains {
+			if domain == "bad..com" {
+				result.Errors = append(result.Errors, DomainAvailabilityError{Domain: domain, Code: "INVALID_DOMAIN"})
+				continue
+			}
+			result.Domains = append(result.Domains, DomainAvailability{Domain: domain, Available: true, Price: 11990000, Currency: "USD"})
+		}
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	domains := []string{"bad..com"}
+	for i := 0; i < maxAvailabilityBatch; i++ {
+		domains = append(domains, fmt.Sprintf("d%d.com", i))
+	}
+
+	result, err := client.CheckDomainsAvailability(context.Background(), domains, AvailabilityOptions{CheckType: CheckTypeFast})
+	if err != nil {
+		t.Fatalf("CheckDomainsAvailability() error = %v", err)
+	}
+	if len(batches) != 2 || batches[0] != maxAvailabilityBatch || batches[1] != 1 {
+		t.Errorf("batches = %v, want [%d 1]", batches, maxAvailabilityBatch)
+	}
+	if len(result.Domains) != maxAvailabilityBatch || len(result.Errors) != 1 || result.Errors[0].Code != "INVALID_DOMAIN" {
+		t.Errorf("CheckDomainsAvailability() returned %d domains and errors %+v", len(result.Domains), result.Errors)
+	}
+
+	if _, err := client.CheckDomainsAvailability(context.Background(), domains, AvailabilityOptions{ForTransfer: true}); err == nil {
+		t.Error("CheckDomainsAvailability() with ForTransfer should fail")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,6 +30,7 @@ type Client struct {
 	baseURL    string
 	apiKey     string
 	apiSecret  string
+	customerID string
 }
 
 type ClientOption func(*Client)
@@ -45,6 +47,22 @@ func WithTestEnvironment() ClientOption {
 	}
 }
 
+// WithBaseURL points the client at another API endpoint, such as a fake
+// server in tests.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithCustomerID sets the customer ID used by the v2 customer-scoped
+// endpoints (/v2/customers/{customerId}/...).
+func WithCustomerID(customerID string) ClientOption {
+	return func(c *Client) {
+		c.customerID = customerID
+	}
+}
+
 func NewClient(apiKey, apiSecret string, opts ...ClientOption) *Client {
 	client := &Client{
 		httpClient: &http.Client{
@@ -62,6 +80,26 @@ func NewClient(apiKey, apiSecret string, opts ...ClientOption) *Client {
 	return client
 }
 
+// HasCustomerID reports whether the v2 customer-scoped endpoints can be used.
+func (c *Client) HasCustomerID() bool {
+	return c.customerID != ""
+}
+
+// customerPath builds a path below /v2/customers/{customerId}. It fails when
+// no customer ID has been configured, since none of the v2 endpoints can be
+// reached without one.
+func (c *Client) customerPath(format string, args ...interface{}) (string, error) {
+	if c.customerID == "" {
+		return "", fmt.Errorf("a customer ID is required for the GoDaddy v2 API")
+	}
+	return fmt.Sprintf("/v2/customers/%s", c.customerID) + fmt.Sprintf(format, args...), nil
+}
+
+// ErrNotFound is returned when GoDaddy has no record of the requested object
+// but the API does not answer with a 404. Callers that treat a missing object
+// as gone check for both.
+var ErrNotFound = errors.New("not found")
+
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	return c.doRequestWithRetry(ctx, method, path, body, 0)
 }
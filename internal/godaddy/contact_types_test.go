@@ -0,0 +1,89 @@
+package godaddy
+
+import (
+	"testing"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone   string
+		want    string
+		wantErr bool
+	}{
+		{phone: "+1.5555551234", want: "+1.5555551234"},
+		{phone: "+15555551234", want: "+1.5555551234"},
+		{phone: "+1 (555) 555-1234", want: "+1.5555551234"},
+		{phone: "+33 1 23 45 67 89", want: "+33.123456789"},
+		{phone: "+353861234567", want: "+353.861234567"},
+		{phone: "+447911123456", want: "+44.7911123456"},
+		{phone: "5555551234", wantErr: true},
+		{phone: "+1.555", wantErr: true},
+		{phone: "+28123456789", wantErr: true},
+		{phone: "not a number", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phone, func(t *testing.T) {
+			got, err := NormalizePhone(tt.phone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizePhone(%q) error = %v, wantErr %v", tt.phone, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCountryCode(t *testing.T) {
+	for _, country := range []string{"US", "ca", "FR", "GB"} {
+		if err := ValidateCountryCode(country); err != nil {
+			t.Errorf("ValidateCountryCode(%q) unexpected error: %v", country, err)
+		}
+	}
+
+	for _, country := range []string{"UK", "USA", "", "XX"} {
+		if err := ValidateCountryCode(country); err == nil {
+			t.Errorf("ValidateCountryCode(%q) expected error", country)
+		}
+	}
+}
+
+func TestValidateEmail(t *testing.T) {
+	for _, email := range []string{"admin@example.com", "first.last+tag@sub.example.co.uk"} {
+		if err := ValidateEmail(email); err != nil {
+			t.Errorf("ValidateEmail(%q) unexpected error: %v", email, err)
+		}
+	}
+
+	for _, email := range []string{"", "admin", "admin@localhost", "Admin <admin@example.com>", "a b@example.com"} {
+		if err := ValidateEmail(email); err == nil {
+			t.Errorf("ValidateEmail(%q) expected error", email)
+		}
+	}
+}
+
+func TestNormalizeContact(t *testing.T) {
+	contact := NormalizeContact(DomainContact{
+		Email: " admin@example.com ",
+		Phone: "+15555551234",
+		Fax:   "+1 555 555 0000",
+		AddressMailing: DomainAddress{
+			State:   " CA",
+			Country: "us",
+		},
+	})
+
+	if contact.Phone != "+1.5555551234" {
+		t.Errorf("Phone = %q, want +1.5555551234", contact.Phone)
+	}
+	if contact.Fax != "+1.5555550000" {
+		t.Errorf("Fax = %q, want +1.5555550000", contact.Fax)
+	}
+	if contact.Email != "admin@example.com" {
+		t.Errorf("Email = %q, want admin@example.com", contact.Email)
+	}
+	if contact.AddressMailing.State != "CA" || contact.AddressMailing.Country != "US" {
+		t.Errorf("Address = %+v, want state CA and country US", contact.AddressMailing)
+	}
+}
@@ -0,0 +1,44 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// defaultMarketID is the market used for localized labels when none is given
+const defaultMarketID = "en-US"
+
+type CountrySummary struct {
+	CountryKey  string `json:"countryKey"`
+	Label       string `json:"label"`
+	CallingCode string `json:"callingCode"`
+}
+
+type Country struct {
+	CountrySummary
+	States []State `json:"states,omitempty"`
+}
+
+type State struct {
+	StateKey string `json:"stateKey"`
+	Label    string `json:"label"`
+}
+
+func (c *Client) ListCountries(ctx context.Context) ([]CountrySummary, error) {
+	var result []CountrySummary
+	err := c.Get(ctx, "/v1/countries?marketId="+defaultMarketID, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list countries: %w", err)
+	}
+	return result, nil
+}
+
+func (c *Client) GetCountry(ctx context.Context, countryKey string) (*Country, error) {
+	var result Country
+	err := c.Get(ctx, fmt.Sprintf("/v1/countries/%s?marketId=%s", url.PathEscape(countryKey), defaultMarketID), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get country %s: %w", countryKey, err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,61 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DNSSECRecord is a DS record published at the registry for a domain, as
+// represented by the v2 API.
+type DNSSECRecord struct {
+	KeyTag     int    `json:"keyTag"`
+	Algorithm  string `json:"algorithm"`
+	DigestType string `json:"digestType"`
+	Digest     string `json:"digest"`
+}
+
+type domainDNSSECRecords struct {
+	DNSSECRecords []DNSSECRecord `json:"dnssecRecords"`
+}
+
+func (c *Client) GetDNSSECRecords(ctx context.Context, domain string) ([]DNSSECRecord, error) {
+	path, err := c.customerPath("/domains/%s?includes=dnssecRecords", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNSSEC records for domain %s: %w", domain, err)
+	}
+
+	var result domainDNSSECRecords
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get DNSSEC records for domain %s: %w", domain, err)
+	}
+	return result.DNSSECRecords, nil
+}
+
+func (c *Client) AddDNSSECRecords(ctx context.Context, domain string, records []DNSSECRecord) error {
+	path, err := c.customerPath("/domains/%s/dnssecRecords", domain)
+	if err != nil {
+		return fmt.Errorf("failed to add DNSSEC records to domain %s: %w", domain, err)
+	}
+
+	if err := c.Patch(ctx, path, records); err != nil {
+		return fmt.Errorf("failed to add DNSSEC records to domain %s: %w", domain, err)
+	}
+	return nil
+}
+
+func (c *Client) RemoveDNSSECRecords(ctx context.Context, domain string, records []DNSSECRecord) error {
+	path, err := c.customerPath("/domains/%s/dnssecRecords", domain)
+	if err != nil {
+		return fmt.Errorf("failed to remove DNSSEC records from domain %s: %w", domain, err)
+	}
+
+	// The records to remove are sent in the body of the DELETE request.
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, records)
+	if err != nil {
+		return fmt.Errorf("failed to remove DNSSEC records from domain %s: %w", domain, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_DNSSECRecords(t *testing.T) {
+	record := DNSSECRecord{KeyTag: 2371, Algorithm: "ECDSAP256SHA256", DigestType: "SHA256", Digest: "1F98"}
+
+	var added, removed []DNSSECRecord
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com":
+			if r.URL.Query().Get("includes") != "dnssecRecords" {
+				t.Errorf("includes = %q, want dnssecRecords", r.URL.Query().Get("includes"))
+			}
+			w.Write([]byte(`{"domain":"example.com","dnssecRecords":[
+				{"keyTag":2371,"algorithm":"ECDSAP256SHA256","digestType":"SHA256","digest":"1F98"}
+			]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/customers/cust-1/domains/example.com/dnssecRecords":
+			json.NewDecoder(r.Body).Decode(&added)
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v2/customers/cust-1/domains/example.com/dnssecRecords":
+			json.NewDecoder(r.Body).Decode(&removed)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+	ctx := context.Background()
+
+	records, err := client.GetDNSSECRecords(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetDNSSECRecords() error = %v", err)
+	}
+	if len(records) != 1 || records[0] != record {
+		t.Errorf("GetDNSSECRecords() = %+v, want [%+v]", records, record)
+	}
+
+	if err := client.AddDNSSECRecords(ctx, "example.com", []DNSSECRecord{record}); err != nil {
+		t.Fatalf("AddDNSSECRecords() error = %v", err)
+	}
+	if len(added) != 1 || added[0] != record {
+		t.Errorf("AddDNSSECRecords() sent %+v, want [%+v]", added, record)
+	}
+
+	if err := client.RemoveDNSSECRecords(ctx, "example.com", []DNSSECRecord{record}); err != nil {
+		t.Fatalf("RemoveDNSSECRecords() error = %v", err)
+	}
+	if len(removed) != 1 || removed[0] != record {
+		t.Errorf("RemoveDNSSECRecords() sent %+v, want [%+v]", removed, record)
+	}
+}
+
+func TestClient_DNSSECRecordsWithoutCustomerID(t *testing.T) {
+	client := NewClient("test-key", "test-secret", WithBaseURL("http://127.0.0.1:0"))
+
+	if _, err := client.GetDNSSECRecords(context.Background(), "example.com"); err == nil {
+		t.Error("GetDNSSECRecords() expected an error without a customer ID")
+	}
+}
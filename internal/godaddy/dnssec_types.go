@@ -0,0 +1,97 @@
+package godaddy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// DNSSEC algorithm numbers (IANA) and the names used by the GoDaddy API
+var dnssecAlgorithms = map[int]string{
+	1:  "RSAMD5",
+	3:  "DSA",
+	5:  "RSASHA1",
+	6:  "DSA_NSEC3_SHA1",
+	7:  "RSASHA1_NSEC3_SHA1",
+	8:  "RSASHA256",
+	10: "RSASHA512",
+	12: "ECC_GOST",
+	13: "ECDSAP256SHA256",
+	14: "ECDSAP384SHA384",
+	15: "ED25519",
+	16: "ED448",
+}
+
+// DS digest type numbers (IANA) and the names used by the GoDaddy API
+var dnssecDigestTypes = map[int]string{
+	1: "SHA1",
+	2: "SHA256",
+	3: "GOST_R_34_11_94",
+	4: "SHA384",
+}
+
+// dnssecDigestLengths is the expected hex length of a digest per digest type
+var dnssecDigestLengths = map[int]int{
+	1: 40,
+	2: 64,
+	3: 64,
+	4: 96,
+}
+
+// DNSSECAlgorithmName returns the API name for an algorithm number
+func DNSSECAlgorithmName(algorithm int) (string, bool) {
+	name, ok := dnssecAlgorithms[algorithm]
+	return name, ok
+}
+
+// DNSSECAlgorithmNumber returns the algorithm number for an API name
+func DNSSECAlgorithmNumber(name string) (int, bool) {
+	for number, n := range dnssecAlgorithms {
+		if strings.EqualFold(n, name) {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+// DNSSECDigestTypeName returns the API name for a digest type number
+func DNSSECDigestTypeName(digestType int) (string, bool) {
+	name, ok := dnssecDigestTypes[digestType]
+	return name, ok
+}
+
+// DNSSECDigestTypeNumber returns the digest type number for an API name
+func DNSSECDigestTypeNumber(name string) (int, bool) {
+	for number, n := range dnssecDigestTypes {
+		if strings.EqualFold(n, name) {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+// ValidateDSRecord validates the fields of a DS record
+func ValidateDSRecord(keyTag, algorithm, digestType int, digest string) error {
+	if keyTag < 0 || keyTag > 65535 {
+		return fmt.Errorf("key tag must be 0-65535")
+	}
+
+	if _, ok := dnssecAlgorithms[algorithm]; !ok {
+		return fmt.Errorf("unsupported DNSSEC algorithm: %d", algorithm)
+	}
+
+	length, ok := dnssecDigestLengths[digestType]
+	if !ok {
+		return fmt.Errorf("unsupported DS digest type: %d", digestType)
+	}
+
+	if len(digest) != length {
+		return fmt.Errorf("digest for digest type %d must be %d hex characters, got %d", digestType, length, len(digest))
+	}
+
+	if _, err := hex.DecodeString(digest); err != nil {
+		return fmt.Errorf("digest must be hexadecimal")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package godaddy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDNSSECAlgorithmNames(t *testing.T) {
+	for number, name := range dnssecAlgorithms {
+		got, ok := DNSSECAlgorithmName(number)
+		if !ok || got != name {
+			t.Errorf("DNSSECAlgorithmName(%d) = %q, %v; want %q", number, got, ok, name)
+		}
+
+		back, ok := DNSSECAlgorithmNumber(strings.ToLower(name))
+		if !ok || back != number {
+			t.Errorf("DNSSECAlgorithmNumber(%q) = %d, %v; want %d", name, back, ok, number)
+		}
+	}
+
+	if _, ok := DNSSECAlgorithmName(2); ok {
+		t.Error("Expected algorithm 2 to be unsupported")
+	}
+}
+
+func TestDNSSECDigestTypeNames(t *testing.T) {
+	for number, name := range dnssecDigestTypes {
+		got, ok := DNSSECDigestTypeName(number)
+		if !ok || got != name {
+			t.Errorf("DNSSECDigestTypeName(%d) = %q, %v; want %q", number, got, ok, name)
+		}
+
+		back, ok := DNSSECDigestTypeNumber(name)
+		if !ok || back != number {
+			t.Errorf("DNSSECDigestTypeNumber(%q) = %d, %v; want %d", name, back, ok, number)
+		}
+	}
+}
+
+func TestValidateDSRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		keyTag     int
+		algorithm  int
+		digestType int
+		digest     string
+		wantErr    bool
+	}{
+		{
+			name:       "valid SHA-256",
+			keyTag:     2371,
+			algorithm:  13,
+			digestType: 2,
+			digest:     strings.Repeat("a1", 32),
+			wantErr:    false,
+		},
+		{
+			name:       "valid SHA-1",
+			keyTag:     60485,
+			algorithm:  5,
+			digestType: 1,
+			digest:     strings.Repeat("B2", 20),
+			wantErr:    false,
+		},
+		{
+			name:       "key tag out of range",
+			keyTag:     70000,
+			algorithm:  13,
+			digestType: 2,
+			digest:     strings.Repeat("a1", 32),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown algorithm",
+			keyTag:     1,
+			algorithm:  99,
+			digestType: 2,
+			digest:     strings.Repeat("a1", 32),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown digest type",
+			keyTag:     1,
+			algorithm:  13,
+			digestType: 9,
+			digest:     strings.Repeat("a1", 32),
+			wantErr:    true,
+		},
+		{
+			name:       "digest length mismatch",
+			keyTag:     1,
+			algorithm:  13,
+			digestType: 4,
+			digest:     strings.Repeat("a1", 32),
+			wantErr:    true,
+		},
+		{
+			name:       "digest not hex",
+			keyTag:     1,
+			algorithm:  13,
+			digestType: 2,
+			digest:     strings.Repeat("zz", 32),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDSRecord(tt.keyTag, tt.algorithm, tt.digestType, tt.digest)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDSRecord() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
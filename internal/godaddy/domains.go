@@ -3,6 +3,9 @@ package godaddy
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 func (c *Client) GetDomain(ctx context.Context, domain string) (*DomainDetail, error) {
@@ -15,14 +18,81 @@ func (c *Client) GetDomain(ctx context.Context, domain string) (*DomainDetail, e
 }
 
 func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
-	var result []Domain
-	err := c.Get(ctx, "/v1/domains", &result)
+	details, err := c.ListDomainsWithOptions(ctx, DomainListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list domains: %w", err)
+		return nil, err
+	}
+
+	result := make([]Domain, len(details))
+	for i, detail := range details {
+		result[i] = detail.Domain
 	}
 	return result, nil
 }
 
+// Domain status groups accepted by ListDomainsWithOptions
+const (
+	StatusGroupInactive          = "INACTIVE"
+	StatusGroupPreRegistration   = "PRE_REGISTRATION"
+	StatusGroupRedemption        = "REDEMPTION"
+	StatusGroupRenewable         = "RENEWABLE"
+	StatusGroupVerificationICANN = "VERIFICATION_ICANN"
+	StatusGroupVisible           = "VISIBLE"
+)
+
+// ValidStatusGroups returns the domain status groups supported by GoDaddy
+func ValidStatusGroups() []string {
+	return []string{
+		StatusGroupInactive,
+		StatusGroupPreRegistration,
+		StatusGroupRedemption,
+		StatusGroupRenewable,
+		StatusGroupVerificationICANN,
+		StatusGroupVisible,
+	}
+}
+
+// domainListPageSize is the number of domains requested per page
+const domainListPageSize = 1000
+
+// DomainListOptions filters the domains returned by ListDomainsWithOptions
+type DomainListOptions struct {
+	Statuses           []string
+	StatusGroups       []string
+	IncludeNameservers bool
+}
+
+// ListDomainsWithOptions lists the domains in the account, following the
+// pagination markers until every page has been read. Nameservers are only
+// filled in when requested.
+func (c *Client) ListDomainsWithOptions(ctx context.Context, opts DomainListOptions) ([]DomainDetail, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(domainListPageSize))
+	if len(opts.Statuses) > 0 {
+		query.Set("statuses", strings.Join(opts.Statuses, ","))
+	}
+	if len(opts.StatusGroups) > 0 {
+		query.Set("statusGroups", strings.Join(opts.StatusGroups, ","))
+	}
+	if opts.IncludeNameservers {
+		query.Set("includes", "nameServers")
+	}
+
+	var result []DomainDetail
+	for {
+		var page []DomainDetail
+		if err := c.Get(ctx, "/v1/domains?"+query.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("failed to list domains: %w", err)
+		}
+		result = append(result, page...)
+
+		if len(page) < domainListPageSize {
+			return result, nil
+		}
+		query.Set("marker", page[len(page)-1].Domain.Domain)
+	}
+}
+
 func (c *Client) UpdateDomain(ctx context.Context, domain string, update DomainUpdate) error {
 	err := c.Patch(ctx, fmt.Sprintf("/v1/domains/%s", domain), update)
 	if err != nil {
@@ -31,9 +101,12 @@ func (c *Client) UpdateDomain(ctx context.Context, domain string, update DomainU
 	return nil
 }
 
-func (c *Client) CheckDomainAvailability(ctx context.Context, domain string) (*DomainAvailability, error) {
+func (c *Client) CheckDomainAvailability(ctx context.Context, domain string, opts AvailabilityOptions) (*DomainAvailability, error) {
+	query := opts.query()
+	query.Set("domain", domain)
+
 	var result DomainAvailability
-	err := c.Get(ctx, fmt.Sprintf("/v1/domains/available?domain=%s", domain), &result)
+	err := c.Get(ctx, "/v1/domains/available?"+query.Encode(), &result)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check domain availability for %s: %w", domain, err)
 	}
@@ -73,6 +146,24 @@ func (c *Client) UpdateDomainContacts(ctx context.Context, domain string, contac
 	return nil
 }
 
+// ValidateDomainContacts asks GoDaddy whether the contacts would be accepted
+// for the given domains, without changing anything.
+func (c *Client) ValidateDomainContacts(ctx context.Context, validation DomainContactsValidation) error {
+	err := c.Post(ctx, "/v1/domains/contacts/validate?marketId="+defaultMarketID, validation, nil)
+	if err != nil {
+		return fmt.Errorf("contact validation failed: %w", err)
+	}
+	return nil
+}
+
+type DomainContactsValidation struct {
+	ContactAdmin      *DomainContact `json:"contactAdmin,omitempty"`
+	ContactBilling    *DomainContact `json:"contactBilling,omitempty"`
+	ContactRegistrant *DomainContact `json:"contactRegistrant,omitempty"`
+	ContactTech       *DomainContact `json:"contactTech,omitempty"`
+	Domains           []string       `json:"domains"`
+}
+
 type DomainContactsResponse struct {
 	ContactAdmin      DomainContact `json:"contactAdmin"`
 	ContactBilling    DomainContact `json:"contactBilling"`
@@ -0,0 +1,61 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_ListDomainsWithOptions(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query := r.URL.Query()
+		if r.URL.Path != "/v1/domains" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if query.Get("statusGroups") != "VISIBLE,RENEWABLE" || query.Get("includes") != "nameServers" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		// A full first page, then a short last page
+		var page []DomainDetail
+		switch query.Get("marker") {
+		case "":
+			for i := 0; i < domainListPageSize; i++ {
+				page = append(page, DomainDetail{Domain: Domain{Domain: fmt.Sprintf("d%04d.com", i)}})
+			}
+		case fmt.Sprintf("d%04d.com", domainListPageSize-1):
+			page = append(page, DomainDetail{
+				Domain:      Domain{Domain: "last.com", Status: "ACTIVE"},
+				Nameservers: []string{"ns1.example.net"},
+			})
+		default:
+			t.Errorf("unexpected marker %q", query.Get("marker"))
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	domains, err := client.ListDomainsWithOptions(context.Background(), DomainListOptions{
+		StatusGroups:       []string{StatusGroupVisible, StatusGroupRenewable},
+		IncludeNameservers: true,
+	})
+	if err != nil {
+		t.Fatalf("ListDomainsWithOptions() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+	if len(domains) != domainListPageSize+1 {
+		t.Fatalf("expected %d domains, got %d", domainListPageSize+1, len(domains))
+	}
+	if last := domains[len(domains)-1]; last.Domain.Domain != "last.com" || len(last.Nameservers) != 1 {
+		t.Errorf("unexpected last domain %+v", last)
+	}
+}
@@ -0,0 +1,90 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Forwarding types
+const (
+	ForwardingRedirectPermanent = "REDIRECT_PERMANENT"
+	ForwardingRedirectTemporary = "REDIRECT_TEMPORARY"
+	ForwardingMasked            = "MASKED"
+)
+
+// ValidForwardingTypes returns the forwarding types supported by GoDaddy
+func ValidForwardingTypes() []string {
+	return []string{ForwardingRedirectPermanent, ForwardingRedirectTemporary, ForwardingMasked}
+}
+
+// ForwardingMask is the page metadata shown for MASKED forwarding
+type ForwardingMask struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Keywords    string `json:"keywords,omitempty"`
+}
+
+type DomainForwarding struct {
+	FQDN string          `json:"fqdn,omitempty"`
+	Type string          `json:"type"`
+	URL  string          `json:"url"`
+	Mask *ForwardingMask `json:"mask,omitempty"`
+}
+
+// GetDomainForwarding returns the forwarding rule of the fqdn
+func (c *Client) GetDomainForwarding(ctx context.Context, fqdn string) (*DomainForwarding, error) {
+	path, err := c.customerPath("/domains/forwards/%s", fqdn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forwarding for %s: %w", fqdn, err)
+	}
+
+	// GoDaddy answers with a list that may include subdomains
+	var result []DomainForwarding
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get forwarding for %s: %w", fqdn, err)
+	}
+
+	for _, forwarding := range result {
+		if forwarding.FQDN == "" || strings.EqualFold(forwarding.FQDN, fqdn) {
+			return &forwarding, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to get forwarding for %s: %w", fqdn, ErrNotFound)
+}
+
+func (c *Client) CreateDomainForwarding(ctx context.Context, fqdn string, forwarding DomainForwarding) error {
+	path, err := c.customerPath("/domains/forwards/%s", fqdn)
+	if err != nil {
+		return fmt.Errorf("failed to create forwarding for %s: %w", fqdn, err)
+	}
+
+	if err := c.Post(ctx, path, forwarding, nil); err != nil {
+		return fmt.Errorf("failed to create forwarding for %s: %w", fqdn, err)
+	}
+	return nil
+}
+
+func (c *Client) UpdateDomainForwarding(ctx context.Context, fqdn string, forwarding DomainForwarding) error {
+	path, err := c.customerPath("/domains/forwards/%s", fqdn)
+	if err != nil {
+		return fmt.Errorf("failed to update forwarding for %s: %w", fqdn, err)
+	}
+
+	if err := c.Put(ctx, path, forwarding); err != nil {
+		return fmt.Errorf("failed to update forwarding for %s: %w", fqdn, err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteDomainForwarding(ctx context.Context, fqdn string) error {
+	path, err := c.customerPath("/domains/forwards/%s", fqdn)
+	if err != nil {
+		return fmt.Errorf("failed to delete forwarding for %s: %w", fqdn, err)
+	}
+
+	if err := c.Delete(ctx, path); err != nil {
+		return fmt.Errorf("failed to delete forwarding for %s: %w", fqdn, err)
+	}
+	return nil
+}
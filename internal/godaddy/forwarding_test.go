@@ -0,0 +1,41 @@
+package godaddy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetDomainForwarding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/customers/cust-1/domains/forwards/example.com":
+			w.Write([]byte(`[
+				{"fqdn":"www.example.com","type":"REDIRECT_TEMPORARY","url":"https://other.example"},
+				{"fqdn":"example.com","type":"MASKED","url":"https://main.example","mask":{"title":"Main"}}
+			]`))
+		case "/v2/customers/cust-1/domains/forwards/missing.example.com":
+			w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+
+	forwarding, err := client.GetDomainForwarding(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("GetDomainForwarding() error = %v", err)
+	}
+	if forwarding.Type != ForwardingMasked || forwarding.Mask == nil || forwarding.Mask.Title != "Main" {
+		t.Errorf("GetDomainForwarding() = %+v, want the example.com rule", forwarding)
+	}
+
+	_, err = client.GetDomainForwarding(context.Background(), "missing.example.com")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDomainForwarding() error = %v, want ErrNotFound", err)
+	}
+}
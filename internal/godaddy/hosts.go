@@ -0,0 +1,104 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// DomainHost is a host object (glue record) registered at the registry for a
+// nameserver below the domain.
+type DomainHost struct {
+	Hostname string   `json:"hostname,omitempty"`
+	IPv4     []string `json:"ipv4"`
+	IPv6     []string `json:"ipv6"`
+}
+
+func (c *Client) GetDomainHost(ctx context.Context, domain, hostname string) (*DomainHost, error) {
+	path, err := c.customerPath("/domains/%s/hosts/%s", domain, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host %s of domain %s: %w", hostname, domain, err)
+	}
+
+	var result DomainHost
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get host %s of domain %s: %w", hostname, domain, err)
+	}
+	return &result, nil
+}
+
+// PutDomainHost creates the host or replaces its addresses.
+func (c *Client) PutDomainHost(ctx context.Context, domain, hostname string, host DomainHost) error {
+	path, err := c.customerPath("/domains/%s/hosts/%s", domain, hostname)
+	if err != nil {
+		return fmt.Errorf("failed to save host %s of domain %s: %w", hostname, domain, err)
+	}
+
+	if err := c.Put(ctx, path, host); err != nil {
+		return fmt.Errorf("failed to save host %s of domain %s: %w", hostname, domain, err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteDomainHost(ctx context.Context, domain, hostname string) error {
+	path, err := c.customerPath("/domains/%s/hosts/%s", domain, hostname)
+	if err != nil {
+		return fmt.Errorf("failed to delete host %s of domain %s: %w", hostname, domain, err)
+	}
+
+	if err := c.Delete(ctx, path); err != nil {
+		return fmt.Errorf("failed to delete host %s of domain %s: %w", hostname, domain, err)
+	}
+	return nil
+}
+
+// ValidateHostInDomain checks that hostname is a valid host name strictly
+// below domain, as required for glue records.
+func ValidateHostInDomain(hostname, domain string) error {
+	host := strings.TrimSuffix(strings.ToLower(hostname), ".")
+	zone := strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	if !strings.HasSuffix(host, "."+zone) {
+		return fmt.Errorf("host %q is not below domain %q", hostname, domain)
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return fmt.Errorf("host %q has an empty or too long label", hostname)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("host %q has a label starting or ending with a hyphen", hostname)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '-' {
+				return fmt.Errorf("host %q contains invalid character %q", hostname, r)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CanonicalIP returns the canonical text form of an IPv4 or IPv6 address, so
+// that equivalent spellings such as 2001:db8:0::1 and 2001:db8::1 compare
+// equal. Invalid addresses are returned unchanged.
+func CanonicalIP(address string) string {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	if ip == nil {
+		return address
+	}
+	return ip.String()
+}
+
+// IsIPv4 reports whether address is a valid IPv4 address.
+func IsIPv4(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() != nil && !strings.Contains(address, ":")
+}
+
+// IsIPv6 reports whether address is a valid IPv6 address.
+func IsIPv6(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && strings.Contains(address, ":")
+}
@@ -0,0 +1,55 @@
+package godaddy
+
+import (
+	"testing"
+)
+
+func TestValidateHostInDomain(t *testing.T) {
+	valid := [][2]string{
+		{"ns1.example.com", "example.com"},
+		{"NS1.Example.com.", "example.com"},
+		{"a.b.example.co.uk", "example.co.uk"},
+	}
+	for _, tt := range valid {
+		if err := ValidateHostInDomain(tt[0], tt[1]); err != nil {
+			t.Errorf("ValidateHostInDomain(%q, %q) unexpected error: %v", tt[0], tt[1], err)
+		}
+	}
+
+	invalid := [][2]string{
+		{"example.com", "example.com"},
+		{"ns1.other.com", "example.com"},
+		{"ns1.badexample.com", "example.com"},
+		{"-ns1.example.com", "example.com"},
+		{"ns_1.example.com", "example.com"},
+		{"ns1..example.com", "example.com"},
+	}
+	for _, tt := range invalid {
+		if err := ValidateHostInDomain(tt[0], tt[1]); err == nil {
+			t.Errorf("ValidateHostInDomain(%q, %q) expected error", tt[0], tt[1])
+		}
+	}
+}
+
+func TestCanonicalIP(t *testing.T) {
+	tests := map[string]string{
+		"192.0.2.1":            "192.0.2.1",
+		"2001:DB8:0:0:0:0:0:1": "2001:db8::1",
+		"2001:db8::1":          "2001:db8::1",
+		"not-an-ip":            "not-an-ip",
+	}
+	for address, want := range tests {
+		if got := CanonicalIP(address); got != want {
+			t.Errorf("CanonicalIP(%q) = %q, want %q", address, got, want)
+		}
+	}
+}
+
+func TestIPFamilies(t *testing.T) {
+	if !IsIPv4("192.0.2.1") || IsIPv4("2001:db8::1") || IsIPv4("::ffff:192.0.2.1") {
+		t.Error("IsIPv4 misclassified an address")
+	}
+	if !IsIPv6("2001:db8::1") || !IsIPv6("::ffff:192.0.2.1") || IsIPv6("192.0.2.1") || IsIPv6("bogus") {
+		t.Error("IsIPv6 misclassified an address")
+	}
+}
@@ -0,0 +1,17 @@
+package godaddy
+
+import "math"
+
+// microsPerUnit is the number of micro-units in one currency unit. GoDaddy
+// expresses every price in micro-units (1 USD = 1000000).
+const microsPerUnit = 1000000
+
+// FromMicros converts a price in micro-units to currency units
+func FromMicros(micros int64) float64 {
+	return float64(micros) / microsPerUnit
+}
+
+// ToMicros converts a price in currency units to micro-units
+func ToMicros(amount float64) int64 {
+	return int64(math.Round(amount * microsPerUnit))
+}
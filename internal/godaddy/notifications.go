@@ -0,0 +1,98 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DomainNotification is a notification about an asynchronous domain
+// operation, such as the completion of an action.
+type DomainNotification struct {
+	NotificationID string                 `json:"notificationId"`
+	Type           string                 `json:"type"`
+	Resource       string                 `json:"resource,omitempty"`
+	ResourceType   string                 `json:"resourceType,omitempty"`
+	Status         string                 `json:"status"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	AddedAt        *time.Time             `json:"addedAt,omitempty"`
+	RequestID      string                 `json:"requestId,omitempty"`
+}
+
+// GetNextDomainNotification returns the oldest unacknowledged notification.
+// GoDaddy answers 204 with no body when there is none, in which case nil is
+// returned.
+func (c *Client) GetNextDomainNotification(ctx context.Context) (*DomainNotification, error) {
+	path, err := c.customerPath("/domains/notifications")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain notifications: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain notifications: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	var result DomainNotification
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode domain notification: %w", err)
+	}
+	return &result, nil
+}
+
+func (c *Client) AcknowledgeDomainNotification(ctx context.Context, notificationID string) error {
+	path, err := c.customerPath("/domains/notifications/%s/acknowledge", notificationID)
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge domain notification %s: %w", notificationID, err)
+	}
+
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to acknowledge domain notification %s: %w", notificationID, err)
+	}
+	return nil
+}
+
+// ListDomainNotificationOptIns returns the notification types the customer
+// has opted in to.
+func (c *Client) ListDomainNotificationOptIns(ctx context.Context) ([]string, error) {
+	path, err := c.customerPath("/domains/notifications/optIn")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domain notification opt-ins: %w", err)
+	}
+
+	var result []struct {
+		Type string `json:"type"`
+	}
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to list domain notification opt-ins: %w", err)
+	}
+
+	types := make([]string, len(result))
+	for i, optIn := range result {
+		types[i] = optIn.Type
+	}
+	return types, nil
+}
+
+// OptInDomainNotifications opts the customer in to the notification types
+func (c *Client) OptInDomainNotifications(ctx context.Context, types []string) error {
+	path, err := c.customerPath("/domains/notifications/optIn")
+	if err != nil {
+		return fmt.Errorf("failed to opt in to domain notifications: %w", err)
+	}
+
+	path += "?types=" + url.QueryEscape(strings.Join(types, ","))
+	if err := c.Put(ctx, path, nil); err != nil {
+		return fmt.Errorf("failed to opt in to domain notifications: %w", err)
+	}
+	return nil
+}
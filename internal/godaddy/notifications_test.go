@@ -0,0 +1,91 @@
+package godaddy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetNextDomainNotification(t *testing.T) {
+	pending := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/customers/cust-1/domains/notifications" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if !pending {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{"notificationId":"n-1","type":"RENEW","resource":"example.com","status":"SUCCESS"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+
+	notification, err := client.GetNextDomainNotification(context.Background())
+	if err != nil {
+		t.Fatalf("GetNextDomainNotification() error = %v", err)
+	}
+	if notification == nil || notification.NotificationID != "n-1" || notification.Resource != "example.com" {
+		t.Errorf("GetNextDomainNotification() = %+v, want notification n-1", notification)
+	}
+
+	pending = false
+	notification, err = client.GetNextDomainNotification(context.Background())
+	if err != nil || notification != nil {
+		t.Errorf("GetNextDomainNotification() = %+v, %v, want nil when there is none", notification, err)
+	}
+}
+
+func TestClient_GetNextDomainNotificationInvalidBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"notificationId":`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+	if _, err := client.GetNextDomainNotification(context.Background()); err == nil {
+		t.Error("GetNextDomainNotification() expected an error for a truncated body")
+	}
+}
+
+func TestClient_DomainNotificationOptIns(t *testing.T) {
+	var acknowledged, optedIn string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/customers/cust-1/domains/notifications/n-1/acknowledge":
+			acknowledged = "n-1"
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/notifications/optIn":
+			w.Write([]byte(`[{"type":"RENEW"},{"type":"TRANSFER_IN"}]`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v2/customers/cust-1/domains/notifications/optIn":
+			optedIn = r.URL.Query().Get("types")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+
+	if err := client.AcknowledgeDomainNotification(ctx, "n-1"); err != nil || acknowledged != "n-1" {
+		t.Errorf("AcknowledgeDomainNotification() error = %v, acknowledged %q", err, acknowledged)
+	}
+
+	types, err := client.ListDomainNotificationOptIns(ctx)
+	if err != nil {
+		t.Fatalf("ListDomainNotificationOptIns() error = %v", err)
+	}
+	if want := []string{"RENEW", "TRANSFER_IN"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("ListDomainNotificationOptIns() = %v, want %v", types, want)
+	}
+
+	if err := client.OptInDomainNotifications(ctx, []string{"RENEW", "REDEEM"}); err != nil || optedIn != "RENEW,REDEEM" {
+		t.Errorf("OptInDomainNotifications() error = %v, types %q", err, optedIn)
+	}
+}
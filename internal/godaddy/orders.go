@@ -0,0 +1,123 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// OrderSummary is an order as returned by ListOrders
+type OrderSummary struct {
+	OrderID       string     `json:"orderId"`
+	ParentOrderID string     `json:"parentOrderId,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	Currency      string     `json:"currency"`
+}
+
+// OrderPricing holds the amounts of an order in micro-units of its currency
+type OrderPricing struct {
+	Discount int64 `json:"discount,omitempty"`
+	List     int64 `json:"list,omitempty"`
+	Savings  int64 `json:"savings,omitempty"`
+	Subtotal int64 `json:"subtotal"`
+	Taxes    int64 `json:"taxes,omitempty"`
+	Total    int64 `json:"total"`
+}
+
+// OrderItemPricing holds the amounts of an order line in micro-units
+type OrderItemPricing struct {
+	List     int64 `json:"list,omitempty"`
+	Sale     int64 `json:"sale,omitempty"`
+	Savings  int64 `json:"savings,omitempty"`
+	Subtotal int64 `json:"subtotal,omitempty"`
+	Taxes    int64 `json:"taxes,omitempty"`
+	Total    int64 `json:"total,omitempty"`
+}
+
+// OrderItem is one line of an order. Domains lists the domain names the line
+// was for, if any.
+type OrderItem struct {
+	Label      string           `json:"label"`
+	PFID       int              `json:"pfid,omitempty"`
+	Period     int              `json:"period,omitempty"`
+	PeriodUnit string           `json:"periodUnit,omitempty"`
+	Quantity   int              `json:"quantity"`
+	Domains    []string         `json:"domains,omitempty"`
+	Pricing    OrderItemPricing `json:"pricing"`
+}
+
+// Order is the full detail of an order
+type Order struct {
+	OrderSummary
+	Items   []OrderItem  `json:"items"`
+	Pricing OrderPricing `json:"pricing"`
+}
+
+// OrderListOptions filters the orders returned by ListOrders. Zero values are
+// left out of the request.
+type OrderListOptions struct {
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Domain         string
+	ProductGroupID int
+	// Limit caps the number of orders returned. Zero reads every page.
+	Limit int
+}
+
+type orderList struct {
+	Orders     []OrderSummary `json:"orders"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+// ListOrders lists the shopper's orders, newest first, reading every page up
+// to opts.Limit orders
+func (c *Client) ListOrders(ctx context.Context, opts OrderListOptions) ([]OrderSummary, error) {
+	pageSize := billingPageSize
+	if opts.Limit > 0 && opts.Limit < pageSize {
+		pageSize = opts.Limit
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(pageSize))
+	query.Set("sort", "-createdAt")
+	if !opts.PeriodStart.IsZero() {
+		query.Set("periodStart", opts.PeriodStart.UTC().Format(time.RFC3339))
+	}
+	if !opts.PeriodEnd.IsZero() {
+		query.Set("periodEnd", opts.PeriodEnd.UTC().Format(time.RFC3339))
+	}
+	if opts.Domain != "" {
+		query.Set("domain", opts.Domain)
+	}
+	if opts.ProductGroupID > 0 {
+		query.Set("productGroupId", strconv.Itoa(opts.ProductGroupID))
+	}
+
+	var result []OrderSummary
+	for {
+		query.Set("offset", strconv.Itoa(len(result)))
+
+		var page orderList
+		if err := c.Get(ctx, "/v1/orders?"+query.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("failed to list orders: %w", err)
+		}
+		result = append(result, page.Orders...)
+
+		if opts.Limit > 0 && len(result) >= opts.Limit {
+			return result[:opts.Limit], nil
+		}
+		if len(page.Orders) < pageSize || len(result) >= page.Pagination.Total {
+			return result, nil
+		}
+	}
+}
+
+func (c *Client) GetOrder(ctx context.Context, orderID string) (*Order, error) {
+	var result Order
+	if err := c.Get(ctx, fmt.Sprintf("/v1/orders/%s", url.PathEscape(orderID)), &result); err != nil {
+		return nil, fmt.Errorf("failed to get order %s: %w", orderID, err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,97 @@
+package godaddy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_ListOrders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orders" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		want := map[string]string{
+			"periodStart":    "2026-01-01T00:00:00Z",
+			"domain":         "example.com",
+			"productGroupId": "4",
+			"offset":         "0",
+		}
+		for name, value := range want {
+			if got := query.Get(name); got != value {
+				t.Errorf("%s = %q, want %q", name, got, value)
+			}
+		}
+		if query.Has("periodEnd") {
+			t.Error("periodEnd should not be sent when unset")
+		}
+
+		w.Write([]byte(`{"orders":[{"orderId":"1001","createdAt":"2026-03-01T10:00:00Z","currency":"USD"}],"pagination":{"total":1}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	orders, err := client.ListOrders(context.Background(), OrderListOptions{
+		PeriodStart:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Domain:         "example.com",
+		ProductGroupID: 4,
+	})
+	if err != nil {
+		t.Fatalf("ListOrders() error = %v", err)
+	}
+	if len(orders) != 1 || orders[0].OrderID != "1001" {
+		t.Errorf("ListOrders() = %+v", orders)
+	}
+}
+
+func TestClient_ListOrdersLimit(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("limit"); got != "2" {
+			t.Errorf("limit = %q, want 2", got)
+		}
+		w.Write([]byte(`{"orders":[{"orderId":"1003"},{"orderId":"1002"}],"pagination":{"total":3}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	orders, err := client.ListOrders(context.Background(), OrderListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListOrders() error = %v", err)
+	}
+	if len(orders) != 2 || requests != 1 {
+		t.Errorf("ListOrders() = %d orders in %d requests, want 2 in 1", len(orders), requests)
+	}
+}
+
+func TestClient_GetOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orders/1001" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"orderId": "1001",
+			"currency": "USD",
+			"items": [{"label": ".COM Domain Name Registration", "quantity": 1, "period": 1, "periodUnit": "YEAR", "domains": ["example.com"], "pricing": {"total": 11990000}}],
+			"pricing": {"subtotal": 11990000, "taxes": 0, "total": 12170000}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	order, err := client.GetOrder(context.Background(), "1001")
+	if err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	if order.Pricing.Total != 12170000 || len(order.Items) != 1 || order.Items[0].Domains[0] != "example.com" {
+		t.Errorf("GetOrder() = %+v", order)
+	}
+}
@@ -0,0 +1,72 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// RedemptionConsent records agreement to the redemption fee and the renewal
+// that comes with restoring a domain. Amounts are in micro-units of Currency.
+type RedemptionConsent struct {
+	AgreedAt string `json:"agreedAt"`
+	AgreedBy string `json:"agreedBy"`
+	Fee      int64  `json:"fee"`
+	Price    int64  `json:"price"`
+	Currency string `json:"currency"`
+}
+
+type DomainRedeem struct {
+	Consent RedemptionConsent `json:"consent"`
+}
+
+// RedemptionQuote is what GoDaddy charges to redeem a domain: the redemption
+// fee and the price of the renewal that comes with it, in micro-units of
+// Currency.
+type RedemptionQuote struct {
+	Fee      int64  `json:"fee"`
+	Price    int64  `json:"price"`
+	Currency string `json:"currency"`
+}
+
+// domainRedemptionDetail is the part of the v2 domain details that describes
+// a pending redemption
+type domainRedemptionDetail struct {
+	Redemption *RedemptionQuote `json:"redemption,omitempty"`
+}
+
+// IsInRedemption reports whether a domain status means the domain has lapsed
+// and can only be restored by redeeming it.
+func IsInRedemption(status string) bool {
+	return strings.Contains(strings.ToUpper(status), "REDEMPTION")
+}
+
+// GetRedemptionQuote returns the charges GoDaddy reports for redeeming a
+// domain in its redemption grace period.
+func (c *Client) GetRedemptionQuote(ctx context.Context, domain string) (*RedemptionQuote, error) {
+	path, err := c.customerPath("/domains/%s", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redemption fee for %s: %w", domain, err)
+	}
+
+	var result domainRedemptionDetail
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get redemption fee for %s: %w", domain, err)
+	}
+	if result.Redemption == nil {
+		return nil, fmt.Errorf("failed to get redemption fee for %s: GoDaddy did not report one", domain)
+	}
+	return result.Redemption, nil
+}
+
+func (c *Client) RedeemDomain(ctx context.Context, domain string, redeem DomainRedeem) error {
+	path, err := c.customerPath("/domains/%s/redeem", domain)
+	if err != nil {
+		return fmt.Errorf("failed to redeem domain %s: %w", domain, err)
+	}
+
+	if err := c.Post(ctx, path, redeem, nil); err != nil {
+		return fmt.Errorf("failed to redeem domain %s: %w", domain, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsInRedemption(t *testing.T) {
+	for _, status := range []string{"REDEMPTION", "PENDING_REDEMPTION", "redemption_pending"} {
+		if !IsInRedemption(status) {
+			t.Errorf("IsInRedemption(%q) = false, want true", status)
+		}
+	}
+
+	for _, status := range []string{"ACTIVE", "EXPIRED", "CANCELLED", ""} {
+		if IsInRedemption(status) {
+			t.Errorf("IsInRedemption(%q) = true, want false", status)
+		}
+	}
+}
+
+func TestClient_RedeemDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/customers/cust-1/domains/example.com/redeem" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body DomainRedeem
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Consent.Fee != 80000000 || body.Consent.Price != 11990000 || body.Consent.Currency != "USD" {
+			t.Errorf("consent = %+v", body.Consent)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+	err := client.RedeemDomain(context.Background(), "example.com", DomainRedeem{
+		Consent: RedemptionConsent{
+			AgreedAt: "2026-01-01T00:00:00Z",
+			AgreedBy: "203.0.113.10",
+			Fee:      80000000,
+			Price:    11990000,
+			Currency: "USD",
+		},
+	})
+	if err != nil {
+		t.Fatalf("RedeemDomain() error = %v", err)
+	}
+
+	if err := NewClient("test-key", "test-secret").RedeemDomain(context.Background(), "example.com", DomainRedeem{}); err == nil {
+		t.Error("RedeemDomain() without a customer ID expected an error")
+	}
+}
+
+func TestClient_GetRedemptionQuote(t *testing.T) {
+	body := `{"domain":"example.com","status":"PENDING_REDEMPTION","redemption":{"fee":80000000,"price":11990000,"currency":"USD"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/customers/cust-1/domains/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL), WithCustomerID("cust-1"))
+	quote, err := client.GetRedemptionQuote(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("GetRedemptionQuote() error = %v", err)
+	}
+	if quote.Fee != 80000000 || quote.Price != 11990000 || quote.Currency != "USD" {
+		t.Errorf("GetRedemptionQuote() = %+v", quote)
+	}
+
+	body = `{"domain":"example.com","status":"ACTIVE"}`
+	if _, err := client.GetRedemptionQuote(context.Background(), "example.com"); err == nil {
+		t.Error("GetRedemptionQuote() expected an error when GoDaddy reports no fee")
+	}
+}
@@ -0,0 +1,78 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChangeOfRegistrantConsent records the registrant's agreement to an ICANN
+// change of registrant, optionally opting out of the 60-day transfer lock.
+type ChangeOfRegistrantConsent struct {
+	AgreedAt           string `json:"agreedAt"`
+	AgreedBy           string `json:"agreedBy"`
+	TransferLockOptOut bool   `json:"transferLockOptOut"`
+}
+
+// DomainContactsUpdateV2 is the body of the v2 contacts update, which
+// accepts a change-of-registrant consent alongside the contacts.
+type DomainContactsUpdateV2 struct {
+	ContactAdmin      *DomainContact             `json:"contactAdmin,omitempty"`
+	ContactBilling    *DomainContact             `json:"contactBilling,omitempty"`
+	ContactRegistrant *DomainContact             `json:"contactRegistrant,omitempty"`
+	ContactTech       *DomainContact             `json:"contactTech,omitempty"`
+	Consent           *ChangeOfRegistrantConsent `json:"consent,omitempty"`
+}
+
+// ChangeOfRegistrant describes a change of registrant awaiting confirmation.
+type ChangeOfRegistrant struct {
+	Status             string     `json:"status"`
+	TransferLockOptOut bool       `json:"transferLockOptOut"`
+	CreatedAt          *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt          *time.Time `json:"expiresAt,omitempty"`
+}
+
+// IsPending reports whether the change is still waiting for confirmation.
+func (c ChangeOfRegistrant) IsPending() bool {
+	return strings.HasPrefix(strings.ToUpper(c.Status), "PENDING")
+}
+
+func (c *Client) UpdateDomainContactsV2(ctx context.Context, domain string, update DomainContactsUpdateV2) error {
+	path, err := c.customerPath("/domains/%s/contacts", domain)
+	if err != nil {
+		return fmt.Errorf("failed to update domain contacts for %s: %w", domain, err)
+	}
+
+	if err := c.Patch(ctx, path, update); err != nil {
+		return fmt.Errorf("failed to update domain contacts for %s: %w", domain, err)
+	}
+	return nil
+}
+
+// GetChangeOfRegistrant returns the pending change of registrant for the
+// domain. GoDaddy answers 404 when there is none.
+func (c *Client) GetChangeOfRegistrant(ctx context.Context, domain string) (*ChangeOfRegistrant, error) {
+	path, err := c.customerPath("/domains/%s/changeOfRegistrant", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get change of registrant for %s: %w", domain, err)
+	}
+
+	var result ChangeOfRegistrant
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get change of registrant for %s: %w", domain, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) CancelChangeOfRegistrant(ctx context.Context, domain string) error {
+	path, err := c.customerPath("/domains/%s/changeOfRegistrant", domain)
+	if err != nil {
+		return fmt.Errorf("failed to cancel change of registrant for %s: %w", domain, err)
+	}
+
+	if err := c.Delete(ctx, path); err != nil {
+		return fmt.Errorf("failed to cancel change of registrant for %s: %w", domain, err)
+	}
+	return nil
+}
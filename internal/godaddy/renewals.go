@@ -0,0 +1,98 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type DomainRenew struct {
+	Period int `json:"period"`
+}
+
+// DomainPurchaseResponse is returned by purchase and renewal requests. Total
+// is in micro-units of Currency.
+type DomainPurchaseResponse struct {
+	OrderID   int64  `json:"orderId"`
+	ItemCount int    `json:"itemCount"`
+	Total     int64  `json:"total"`
+	Currency  string `json:"currency"`
+}
+
+// ListPriceQuote is the list price of a domain's TLD scaled to a number of
+// years. Price is in currency units for the whole period. GoDaddy doesn't
+// report what a domain's subscription will cost at renewal, so this is the
+// closest estimate; discounts and locked prices are not taken into account.
+type ListPriceQuote struct {
+	Price    float64
+	Currency string
+	Period   int
+}
+
+// GetListPriceQuote returns the list price GoDaddy's availability check
+// reports for the domain, scaled to period years. It is the registration
+// price of the TLD, not the renewal price of the domain's subscription.
+func (c *Client) GetListPriceQuote(ctx context.Context, domain string, period int) (*ListPriceQuote, error) {
+	availability, err := c.CheckDomainAvailability(ctx, domain, AvailabilityOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list price for %s: %w", domain, err)
+	}
+
+	quote, ok := listPriceQuote(*availability, period)
+	if !ok {
+		return nil, fmt.Errorf("failed to get list price for %s: GoDaddy did not report a price", domain)
+	}
+	return &quote, nil
+}
+
+func (c *Client) RenewDomain(ctx context.Context, domain string, period int) (*DomainPurchaseResponse, error) {
+	var result DomainPurchaseResponse
+	if err := c.Post(ctx, fmt.Sprintf("/v1/domains/%s/renew", domain), DomainRenew{Period: period}, &result); err != nil {
+		return nil, fmt.Errorf("failed to renew domain %s: %w", domain, err)
+	}
+	return &result, nil
+}
+
+// GetListPriceQuotes returns the list prices of many domains for period
+// years, like GetListPriceQuote, with one bulk availability check per 500
+// domains. Domains GoDaddy reports no price for are left out of the result,
+// which is keyed by lower-cased domain name.
+func (c *Client) GetListPriceQuotes(ctx context.Context, domains []string, period int) (map[string]ListPriceQuote, error) {
+	result := make(map[string]ListPriceQuote, len(domains))
+	if len(domains) == 0 {
+		return result, nil
+	}
+
+	bulk, err := c.CheckDomainsAvailability(ctx, domains, AvailabilityOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list prices: %w", err)
+	}
+
+	for _, availability := range bulk.Domains {
+		if quote, ok := listPriceQuote(availability, period); ok {
+			result[strings.ToLower(availability.Domain)] = quote
+		}
+	}
+	return result, nil
+}
+
+// listPriceQuote scales the list price of an availability check to period
+// years. It reports false when the check has no price.
+func listPriceQuote(availability DomainAvailability, period int) (ListPriceQuote, bool) {
+	if availability.Price <= 0 {
+		return ListPriceQuote{}, false
+	}
+
+	// The price covers the period the availability check was made for
+	years := availability.Period
+	if years <= 0 {
+		years = 1
+	}
+	yearly := FromMicros(int64(availability.Price)) / float64(years)
+
+	return ListPriceQuote{
+		Price:    yearly * float64(period),
+		Currency: availability.Currency,
+		Period:   period,
+	}, true
+}
@@ -0,0 +1,119 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetListPriceQuote(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		period    int
+		wantPrice float64
+		wantErr   bool
+	}{
+		{
+			name:      "one year price",
+			response:  `{"domain":"example.com","available":false,"price":11990000,"currency":"USD","period":1}`,
+			period:    2,
+			wantPrice: 23.98,
+		},
+		{
+			name:      "multi-year price",
+			response:  `{"domain":"example.com","available":false,"price":20000000,"currency":"USD","period":2}`,
+			period:    3,
+			wantPrice: 30,
+		},
+		{
+			name:     "no price",
+			response: `{"domain":"example.com","available":false}`,
+			period:   1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/domains/available" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+			quote, err := client.GetListPriceQuote(context.Background(), "example.com", tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetListPriceQuote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if math.Abs(quote.Price-tt.wantPrice) > 0.001 {
+				t.Errorf("GetListPriceQuote() price = %v, want %v", quote.Price, tt.wantPrice)
+			}
+			if quote.Currency != "USD" {
+				t.Errorf("GetListPriceQuote() currency = %v, want USD", quote.Currency)
+			}
+		})
+	}
+}
+
+func TestClient_RenewDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/domains/example.com/renew" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body DomainRenew
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Period != 2 {
+			t.Errorf("period = %d, want 2", body.Period)
+		}
+
+		w.Write([]byte(`{"orderId":1234,"itemCount":1,"total":23980000,"currency":"USD"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+	order, err := client.RenewDomain(context.Background(), "example.com", 2)
+	if err != nil {
+		t.Fatalf("RenewDomain() error = %v", err)
+	}
+	if order.OrderID != 1234 || order.Total != 23980000 {
+		t.Errorf("RenewDomain() = %+v", order)
+	}
+}
+
+func TestClient_GetListPriceQuotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/domains/available" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"domains":[
+			{"domain":"Example.com","available":false,"price":11990000,"currency":"USD","period":1},
+			{"domain":"example.shop","available":false}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+	quotes, err := client.GetListPriceQuotes(context.Background(), []string{"example.com", "example.shop"}, 2)
+	if err != nil {
+		t.Fatalf("GetListPriceQuotes() error = %v", err)
+	}
+	if len(quotes) != 1 {
+		t.Fatalf("GetListPriceQuotes() = %+v, want only example.com", quotes)
+	}
+	if quote := quotes["example.com"]; math.Abs(quote.Price-23.98) > 0.001 || quote.Currency != "USD" {
+		t.Errorf("example.com quote = %+v", quote)
+	}
+}
@@ -0,0 +1,95 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DefaultMarketID is the market GoDaddy assigns to new shoppers
+const DefaultMarketID = "en-US"
+
+// Shopper is a GoDaddy account, such as a reseller sub-account
+type Shopper struct {
+	ShopperID  string `json:"shopperId"`
+	CustomerID string `json:"customerId,omitempty"`
+	NameFirst  string `json:"nameFirst"`
+	NameLast   string `json:"nameLast"`
+	Email      string `json:"email"`
+	ExternalID *int64 `json:"externalId,omitempty"`
+	MarketID   string `json:"marketId,omitempty"`
+}
+
+// SubaccountCreate is the request body for creating a sub-account
+type SubaccountCreate struct {
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	NameFirst  string `json:"nameFirst"`
+	NameLast   string `json:"nameLast"`
+	ExternalID *int64 `json:"externalId,omitempty"`
+	MarketID   string `json:"marketId,omitempty"`
+}
+
+// ShopperUpdate is the request body for changing a shopper's details
+type ShopperUpdate struct {
+	Email      string `json:"email,omitempty"`
+	NameFirst  string `json:"nameFirst,omitempty"`
+	NameLast   string `json:"nameLast,omitempty"`
+	ExternalID *int64 `json:"externalId,omitempty"`
+	MarketID   string `json:"marketId,omitempty"`
+}
+
+// ShopperID identifies a shopper and its customer
+type ShopperID struct {
+	ShopperID  string `json:"shopperId"`
+	CustomerID string `json:"customerId,omitempty"`
+}
+
+// CreateSubaccount creates a sub-account owned by the reseller making the call
+func (c *Client) CreateSubaccount(ctx context.Context, subaccount SubaccountCreate) (*ShopperID, error) {
+	var result ShopperID
+	if err := c.Post(ctx, "/v1/shoppers/subaccount", subaccount, &result); err != nil {
+		return nil, fmt.Errorf("failed to create sub-account for %s: %w", subaccount.Email, err)
+	}
+	return &result, nil
+}
+
+// GetShopper returns the shopper, including its customer ID
+func (c *Client) GetShopper(ctx context.Context, shopperID string) (*Shopper, error) {
+	var result Shopper
+	path := fmt.Sprintf("/v1/shoppers/%s?includes=customerId", url.PathEscape(shopperID))
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get shopper %s: %w", shopperID, err)
+	}
+	return &result, nil
+}
+
+// UpdateShopper changes the details of a shopper. Empty fields are left as
+// they are.
+func (c *Client) UpdateShopper(ctx context.Context, shopperID string, update ShopperUpdate) error {
+	path := fmt.Sprintf("/v1/shoppers/%s", url.PathEscape(shopperID))
+	if err := c.Post(ctx, path, update, nil); err != nil {
+		return fmt.Errorf("failed to update shopper %s: %w", shopperID, err)
+	}
+	return nil
+}
+
+// SetShopperPassword replaces the password of a sub-account
+func (c *Client) SetShopperPassword(ctx context.Context, shopperID, password string) error {
+	path := fmt.Sprintf("/v1/shoppers/%s/factors/password", url.PathEscape(shopperID))
+	body := map[string]string{"secret": password}
+	if err := c.Put(ctx, path, body); err != nil {
+		return fmt.Errorf("failed to set password of shopper %s: %w", shopperID, err)
+	}
+	return nil
+}
+
+// DeleteShopper deletes a sub-account. GoDaddy requires the IP address of the
+// person requesting the deletion for its audit log.
+func (c *Client) DeleteShopper(ctx context.Context, shopperID, auditClientIP string) error {
+	path := fmt.Sprintf("/v1/shoppers/%s?auditClientIp=%s", url.PathEscape(shopperID), url.QueryEscape(auditClientIP))
+	if err := c.Delete(ctx, path); err != nil {
+		return fmt.Errorf("failed to delete shopper %s: %w", shopperID, err)
+	}
+	return nil
+}
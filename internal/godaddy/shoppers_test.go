@@ -0,0 +1,83 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CreateSubaccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/shoppers/subaccount" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body SubaccountCreate
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Email != "jane@example.com" || body.Password != "s3cret!" || body.MarketID != "en-GB" {
+			t.Errorf("unexpected body %+v", body)
+		}
+
+		w.Write([]byte(`{"shopperId":"123456","customerId":"cust-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	id, err := client.CreateSubaccount(context.Background(), SubaccountCreate{
+		Email:     "jane@example.com",
+		Password:  "s3cret!",
+		NameFirst: "Jane",
+		NameLast:  "Doe",
+		MarketID:  "en-GB",
+	})
+	if err != nil {
+		t.Fatalf("CreateSubaccount() error = %v", err)
+	}
+	if id.ShopperID != "123456" || id.CustomerID != "cust-1" {
+		t.Errorf("CreateSubaccount() = %+v", id)
+	}
+}
+
+func TestClient_GetShopper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/shoppers/123456" || r.URL.Query().Get("includes") != "customerId" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"shopperId":"123456","customerId":"cust-1","nameFirst":"Jane","nameLast":"Doe","email":"jane@example.com","externalId":42,"marketId":"en-US"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	shopper, err := client.GetShopper(context.Background(), "123456")
+	if err != nil {
+		t.Fatalf("GetShopper() error = %v", err)
+	}
+	if shopper.CustomerID != "cust-1" || shopper.ExternalID == nil || *shopper.ExternalID != 42 {
+		t.Errorf("GetShopper() = %+v", shopper)
+	}
+}
+
+func TestClient_DeleteShopper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v1/shoppers/123456" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if ip := r.URL.Query().Get("auditClientIp"); ip != "203.0.113.7" {
+			t.Errorf("auditClientIp = %q, want 203.0.113.7", ip)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	if err := client.DeleteShopper(context.Background(), "123456", "203.0.113.7"); err != nil {
+		t.Fatalf("DeleteShopper() error = %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProductGroupDomains is the product group key of domain subscriptions
+const ProductGroupDomains = "domains"
+
+// billingPageSize is the number of subscriptions or orders requested per page
+const billingPageSize = 100
+
+// Pagination describes a page of a subscription or order listing
+type Pagination struct {
+	First    string `json:"first,omitempty"`
+	Last     string `json:"last,omitempty"`
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+	Total    int    `json:"total"`
+}
+
+// SubscriptionBilling is the billing state of a subscription
+type SubscriptionBilling struct {
+	Commitment string     `json:"commitment,omitempty"`
+	RenewAt    *time.Time `json:"renewAt,omitempty"`
+	Status     string     `json:"status,omitempty"`
+}
+
+// SubscriptionProduct is the product a subscription is for
+type SubscriptionProduct struct {
+	Label             string `json:"label"`
+	Namespace         string `json:"namespace,omitempty"`
+	ProductGroupKey   string `json:"productGroupKey"`
+	PFID              int    `json:"pfid,omitempty"`
+	RenewalPFID       int    `json:"renewalPfid,omitempty"`
+	RenewalPeriod     int    `json:"renewalPeriod,omitempty"`
+	RenewalPeriodUnit string `json:"renewalPeriodUnit,omitempty"`
+}
+
+// Subscription is a product the shopper pays for on a recurring basis
+type Subscription struct {
+	SubscriptionID string              `json:"subscriptionId"`
+	Label          string              `json:"label"`
+	Status         string              `json:"status"`
+	CreatedAt      *time.Time          `json:"createdAt,omitempty"`
+	ExpiresAt      *time.Time          `json:"expiresAt,omitempty"`
+	RenewAuto      bool                `json:"renewAuto"`
+	Renewable      bool                `json:"renewable"`
+	PriceLocked    bool                `json:"priceLocked"`
+	Billing        SubscriptionBilling `json:"billing"`
+	Product        SubscriptionProduct `json:"product"`
+}
+
+// Domain returns the domain name of a domain subscription, which GoDaddy
+// uses as its label, or an empty string for other products
+func (s Subscription) Domain() string {
+	if s.Product.ProductGroupKey != ProductGroupDomains {
+		return ""
+	}
+	return strings.ToLower(s.Label)
+}
+
+// SubscriptionListOptions filters the subscriptions returned by
+// ListSubscriptions
+type SubscriptionListOptions struct {
+	ProductGroupKeys []string
+}
+
+type subscriptionList struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+	Pagination    Pagination     `json:"pagination"`
+}
+
+// ListSubscriptions lists the shopper's subscriptions, soonest to expire
+// first, reading every page
+func (c *Client) ListSubscriptions(ctx context.Context, opts SubscriptionListOptions) ([]Subscription, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(billingPageSize))
+	query.Set("sort", "expiresAt")
+	if len(opts.ProductGroupKeys) > 0 {
+		query.Set("productGroupKeys", strings.Join(opts.ProductGroupKeys, ","))
+	}
+
+	var result []Subscription
+	for {
+		query.Set("offset", strconv.Itoa(len(result)))
+
+		var page subscriptionList
+		if err := c.Get(ctx, "/v1/subscriptions?"+query.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+		result = append(result, page.Subscriptions...)
+
+		if len(page.Subscriptions) < billingPageSize || len(result) >= page.Pagination.Total {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestClient_ListSubscriptions(t *testing.T) {
+	const total = billingPageSize + 2
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v1/subscriptions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("productGroupKeys"); got != "domains,ssl" {
+			t.Errorf("productGroupKeys = %q", got)
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+
+		end := offset + billingPageSize
+		if end > total {
+			end = total
+		}
+		subscriptions := ""
+		for i := offset; i < end; i++ {
+			if subscriptions != "" {
+				subscriptions += ","
+			}
+			subscriptions += fmt.Sprintf(`{"subscriptionId":"sub-%d","label":"EXAMPLE%d.COM","status":"ACTIVE","product":{"productGroupKey":"domains","renewalPeriod":1,"renewalPeriodUnit":"YEAR"},"billing":{"renewAt":"2027-01-01T00:00:00Z"}}`, i, i)
+		}
+		fmt.Fprintf(w, `{"subscriptions":[%s],"pagination":{"total":%d}}`, subscriptions, total)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	subscriptions, err := client.ListSubscriptions(context.Background(), SubscriptionListOptions{
+		ProductGroupKeys: []string{ProductGroupDomains, "ssl"},
+	})
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(subscriptions) != total || requests != 2 {
+		t.Fatalf("ListSubscriptions() returned %d subscriptions in %d requests", len(subscriptions), requests)
+	}
+	if got := subscriptions[1].Domain(); got != "example1.com" {
+		t.Errorf("Domain() = %q, want example1.com", got)
+	}
+	if subscriptions[0].Billing.RenewAt == nil {
+		t.Error("billing.renewAt was not decoded")
+	}
+}
+
+func TestSubscription_Domain(t *testing.T) {
+	ssl := Subscription{Label: "Standard SSL", Product: SubscriptionProduct{ProductGroupKey: "ssl"}}
+	if got := ssl.Domain(); got != "" {
+		t.Errorf("Domain() = %q for a non-domain subscription", got)
+	}
+}
@@ -0,0 +1,80 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Sources of domain suggestions
+const (
+	SuggestionSourceCCTLD       = "CC_TLD"
+	SuggestionSourceExtension   = "EXTENSION"
+	SuggestionSourceKeywordSpin = "KEYWORD_SPIN"
+	SuggestionSourcePremium     = "PREMIUM"
+)
+
+// ValidSuggestionSources returns the suggestion sources supported by GoDaddy
+func ValidSuggestionSources() []string {
+	return []string{
+		SuggestionSourceCCTLD,
+		SuggestionSourceExtension,
+		SuggestionSourceKeywordSpin,
+		SuggestionSourcePremium,
+	}
+}
+
+// DomainSuggestion is a domain name suggested for a query
+type DomainSuggestion struct {
+	Domain string `json:"domain"`
+}
+
+// SuggestionOptions narrows down the suggestions for a query. Zero values are
+// left out of the request.
+type SuggestionOptions struct {
+	Country   string
+	City      string
+	Sources   []string
+	TLDs      []string
+	LengthMin int
+	LengthMax int
+	Limit     int
+	WaitMs    int
+}
+
+// SuggestDomains returns domain names suggested for the query, which may be a
+// domain name or a set of keywords
+func (c *Client) SuggestDomains(ctx context.Context, query string, opts SuggestionOptions) ([]DomainSuggestion, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if opts.Country != "" {
+		params.Set("country", opts.Country)
+	}
+	if opts.City != "" {
+		params.Set("city", opts.City)
+	}
+	if len(opts.Sources) > 0 {
+		params.Set("sources", strings.Join(opts.Sources, ","))
+	}
+	if len(opts.TLDs) > 0 {
+		params.Set("tlds", strings.Join(opts.TLDs, ","))
+	}
+	for name, value := range map[string]int{
+		"lengthMin": opts.LengthMin,
+		"lengthMax": opts.LengthMax,
+		"limit":     opts.Limit,
+		"waitMs":    opts.WaitMs,
+	} {
+		if value > 0 {
+			params.Set(name, strconv.Itoa(value))
+		}
+	}
+
+	var result []DomainSuggestion
+	if err := c.Get(ctx, "/v1/domains/suggest?"+params.Encode(), &result); err != nil {
+		return nil, fmt.Errorf("failed to get domain suggestions for %q: %w", query, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package godaddy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_SuggestDomains(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/suggest" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		want := map[string]string{
+			"query":     "coffee shop",
+			"country":   "US",
+			"sources":   "KEYWORD_SPIN,CC_TLD",
+			"tlds":      "com,cafe",
+			"lengthMax": "20",
+			"limit":     "5",
+			"waitMs":    "1000",
+		}
+		for name, value := range want {
+			if got := query.Get(name); got != value {
+				t.Errorf("%s = %q, want %q", name, got, value)
+			}
+		}
+		for _, name := range []string{"city", "lengthMin"} {
+			if query.Has(name) {
+				t.Errorf("%s should not be sent when unset", name)
+			}
+		}
+
+		w.Write([]byte(`[{"domain":"coffeeshop.cafe"},{"domain":"bestcoffeeshop.com"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	suggestions, err := client.SuggestDomains(context.Background(), "coffee shop", SuggestionOptions{
+		Country:   "US",
+		Sources:   []string{SuggestionSourceKeywordSpin, SuggestionSourceCCTLD},
+		TLDs:      []string{"com", "cafe"},
+		LengthMax: 20,
+		Limit:     5,
+		WaitMs:    1000,
+	})
+	if err != nil {
+		t.Fatalf("SuggestDomains() error = %v", err)
+	}
+	if len(suggestions) != 2 || suggestions[0].Domain != "coffeeshop.cafe" {
+		t.Errorf("SuggestDomains() = %+v", suggestions)
+	}
+}
@@ -0,0 +1,148 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// TLD types
+const (
+	TLDTypeCountryCode = "COUNTRY_CODE"
+	TLDTypeGeneric     = "GENERIC"
+)
+
+// TLD is a top-level domain sold by GoDaddy
+type TLD struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func (c *Client) ListTLDs(ctx context.Context) ([]TLD, error) {
+	var result []TLD
+	if err := c.Get(ctx, "/v1/domains/tlds", &result); err != nil {
+		return nil, fmt.Errorf("failed to list TLDs: %w", err)
+	}
+	return result, nil
+}
+
+// PurchaseSchemaProperty describes one field of a purchase request
+type PurchaseSchemaProperty struct {
+	Type      string                  `json:"type,omitempty"`
+	Ref       string                  `json:"$ref,omitempty"`
+	Items     *PurchaseSchemaProperty `json:"items,omitempty"`
+	Format    string                  `json:"format,omitempty"`
+	Pattern   string                  `json:"pattern,omitempty"`
+	Enum      []string                `json:"enum,omitempty"`
+	Minimum   *float64                `json:"minimum,omitempty"`
+	Maximum   *float64                `json:"maximum,omitempty"`
+	MinItems  *int                    `json:"minItems,omitempty"`
+	MaxItems  *int                    `json:"maxItems,omitempty"`
+	MinLength *int                    `json:"minLength,omitempty"`
+	MaxLength *int                    `json:"maxLength,omitempty"`
+	// Required is either a boolean on the property or, for inline objects,
+	// the list of required nested properties
+	Required json.RawMessage `json:"required,omitempty"`
+}
+
+// PurchaseSchemaModel is a named object type referenced from the schema
+type PurchaseSchemaModel struct {
+	ID         string                            `json:"id,omitempty"`
+	Properties map[string]PurchaseSchemaProperty `json:"properties"`
+	Required   []string                          `json:"required,omitempty"`
+}
+
+// PurchaseSchema is the JSON schema of a purchase request for a TLD
+type PurchaseSchema struct {
+	ID         string                            `json:"id,omitempty"`
+	Models     map[string]PurchaseSchemaModel    `json:"models,omitempty"`
+	Properties map[string]PurchaseSchemaProperty `json:"properties"`
+	Required   []string                          `json:"required,omitempty"`
+	// Raw is the schema as returned by GoDaddy
+	Raw string `json:"-"`
+}
+
+// PurchaseSchemaField is a property of the schema with its dotted path from
+// the top of the purchase request, such as contactRegistrant.nameFirst
+type PurchaseSchemaField struct {
+	Path     string
+	Required bool
+	PurchaseSchemaProperty
+}
+
+func (c *Client) GetPurchaseSchema(ctx context.Context, tld string) (*PurchaseSchema, error) {
+	var raw json.RawMessage
+	if err := c.Get(ctx, fmt.Sprintf("/v1/domains/purchase/schema/%s", url.PathEscape(tld)), &raw); err != nil {
+		return nil, fmt.Errorf("failed to get purchase schema for %s: %w", tld, err)
+	}
+
+	var result PurchaseSchema
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode purchase schema for %s: %w", tld, err)
+	}
+	result.Raw = string(raw)
+	return &result, nil
+}
+
+// Fields flattens the schema into its leaf fields, following references to
+// models. A field is required when it and all of its parents are required.
+func (s *PurchaseSchema) Fields() []PurchaseSchemaField {
+	var fields []PurchaseSchemaField
+	s.collect("", s.Properties, s.Required, true, map[string]bool{}, &fields)
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Path < fields[j].Path })
+	return fields
+}
+
+func (s *PurchaseSchema) collect(prefix string, properties map[string]PurchaseSchemaProperty, required []string, parentRequired bool, visiting map[string]bool, fields *[]PurchaseSchemaField) {
+	for name, property := range properties {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		isRequired := parentRequired && (containsName(required, name) || property.isRequired())
+
+		// Arrays of objects are described by their items
+		ref := property.Ref
+		if ref == "" && property.Items != nil {
+			ref = property.Items.Ref
+		}
+
+		model, ok := s.model(ref)
+		if !ok || visiting[ref] {
+			*fields = append(*fields, PurchaseSchemaField{Path: path, Required: isRequired, PurchaseSchemaProperty: property})
+			continue
+		}
+
+		visiting[ref] = true
+		s.collect(path, model.Properties, model.Required, isRequired, visiting, fields)
+		delete(visiting, ref)
+	}
+}
+
+// model looks up a referenced model by name or by "#/models/<name>"
+func (s *PurchaseSchema) model(ref string) (PurchaseSchemaModel, bool) {
+	if ref == "" {
+		return PurchaseSchemaModel{}, false
+	}
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	model, ok := s.Models[name]
+	return model, ok
+}
+
+func (p PurchaseSchemaProperty) isRequired() bool {
+	var required bool
+	return json.Unmarshal(p.Required, &required) == nil && required
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
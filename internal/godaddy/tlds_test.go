@@ -0,0 +1,118 @@
+package godaddy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const usPurchaseSchema = `{
+	"id": "https://api.godaddy.com/DomainPurchase#",
+	"models": {
+		"Contact": {
+			"id": "Contact",
+			"properties": {
+				"nameFirst": {"type": "string", "maxLength": 30, "required": true},
+				"fax": {"type": "string"},
+				"addressMailing": {"$ref": "#/models/Address", "required": true}
+			}
+		},
+		"Address": {
+			"id": "Address",
+			"properties": {
+				"country": {"type": "string", "enum": ["US", "CA"]},
+				"address1": {"type": "string"}
+			},
+			"required": ["country"]
+		},
+		"Consent": {
+			"id": "Consent",
+			"properties": {
+				"agreementKeys": {"type": "array", "items": {"type": "string"}, "required": true}
+			}
+		}
+	},
+	"properties": {
+		"domain": {"type": "string", "format": "domain", "required": true},
+		"contactRegistrant": {"$ref": "Contact", "required": true},
+		"contactTech": {"$ref": "Contact"},
+		"consent": {"$ref": "Consent", "required": true},
+		"usNexus": {"type": "string", "pattern": "^C[1-3][12]$", "required": true}
+	}
+}`
+
+func TestClient_GetPurchaseSchema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/purchase/schema/us" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(usPurchaseSchema))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	schema, err := client.GetPurchaseSchema(context.Background(), "us")
+	if err != nil {
+		t.Fatalf("GetPurchaseSchema() error = %v", err)
+	}
+	if !strings.Contains(schema.Raw, "usNexus") {
+		t.Errorf("Raw does not hold the schema: %s", schema.Raw)
+	}
+
+	fields := map[string]PurchaseSchemaField{}
+	for _, field := range schema.Fields() {
+		fields[field.Path] = field
+	}
+
+	want := map[string]bool{
+		"domain":                      true,
+		"usNexus":                     true,
+		"consent.agreementKeys":       true,
+		"contactRegistrant.nameFirst": true,
+		"contactRegistrant.fax":       false,
+		"contactRegistrant.addressMailing.country":  true,
+		"contactRegistrant.addressMailing.address1": false,
+		"contactTech.nameFirst":                     false,
+		"contactTech.addressMailing.country":        false,
+	}
+	for path, required := range want {
+		field, ok := fields[path]
+		if !ok {
+			t.Errorf("missing field %s", path)
+			continue
+		}
+		if field.Required != required {
+			t.Errorf("%s required = %v, want %v", path, field.Required, required)
+		}
+	}
+
+	if nexus := fields["usNexus"]; nexus.Pattern != "^C[1-3][12]$" {
+		t.Errorf("usNexus pattern = %q", nexus.Pattern)
+	}
+	if _, ok := fields["contactRegistrant"]; ok {
+		t.Error("model references should be expanded into their fields")
+	}
+}
+
+func TestClient_ListTLDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/tlds" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"name":"com","type":"GENERIC"},{"name":"us","type":"COUNTRY_CODE"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", "test-secret", WithBaseURL(server.URL))
+
+	tlds, err := client.ListTLDs(context.Background())
+	if err != nil {
+		t.Fatalf("ListTLDs() error = %v", err)
+	}
+	if len(tlds) != 2 || tlds[1].Type != TLDTypeCountryCode {
+		t.Errorf("ListTLDs() = %+v", tlds)
+	}
+}
@@ -0,0 +1,135 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TransferConsent is the consent required to transfer a domain in. Price is
+// in micro-units of Currency.
+type TransferConsent struct {
+	AgreedAt      string   `json:"agreedAt"`
+	AgreedBy      string   `json:"agreedBy"`
+	AgreementKeys []string `json:"agreementKeys"`
+	Price         int64    `json:"price,omitempty"`
+	Currency      string   `json:"currency,omitempty"`
+}
+
+type DomainTransferIn struct {
+	AuthCode  string          `json:"authCode"`
+	Consent   TransferConsent `json:"consent"`
+	Period    int             `json:"period,omitempty"`
+	Privacy   bool            `json:"privacy"`
+	RenewAuto bool            `json:"renewAuto"`
+}
+
+type DomainTransferStatus struct {
+	Domain     string     `json:"domain"`
+	Status     string     `json:"status"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	ModifiedAt *time.Time `json:"modifiedAt,omitempty"`
+}
+
+// IsFailed reports whether the transfer ended without completing.
+func (s DomainTransferStatus) IsFailed() bool {
+	status := strings.ToUpper(s.Status)
+	return strings.Contains(status, "FAIL") || strings.Contains(status, "CANCEL") ||
+		strings.Contains(status, "REJECT") || strings.Contains(status, "DENIED")
+}
+
+func (c *Client) StartDomainTransferIn(ctx context.Context, domain string, transfer DomainTransferIn) error {
+	path, err := c.customerPath("/domains/%s/transfer", domain)
+	if err != nil {
+		return fmt.Errorf("failed to start transfer of domain %s: %w", domain, err)
+	}
+
+	if err := c.Post(ctx, path, transfer, nil); err != nil {
+		return fmt.Errorf("failed to start transfer of domain %s: %w", domain, err)
+	}
+	return nil
+}
+
+func (c *Client) GetDomainTransferStatus(ctx context.Context, domain string) (*DomainTransferStatus, error) {
+	path, err := c.customerPath("/domains/%s/transfer", domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer status of domain %s: %w", domain, err)
+	}
+
+	var result DomainTransferStatus
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get transfer status of domain %s: %w", domain, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) CancelDomainTransferIn(ctx context.Context, domain string) error {
+	path, err := c.customerPath("/domains/%s/transferInCancel", domain)
+	if err != nil {
+		return fmt.Errorf("failed to cancel transfer of domain %s: %w", domain, err)
+	}
+
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to cancel transfer of domain %s: %w", domain, err)
+	}
+	return nil
+}
+
+// Reasons accepted by the registry when rejecting an outbound transfer.
+const (
+	TransferOutRejectEvidenceOfFraud         = "EVIDENCE_OF_FRAUD"
+	TransferOutRejectURDPAction              = "URDP_ACTION"
+	TransferOutRejectCourtOrder              = "COURT_ORDER"
+	TransferOutRejectDisputeOverIdentity     = "DISPUTE_OVER_IDENTITY"
+	TransferOutRejectNoPayment               = "NO_PAYMENT_FOR_PREVIOUS_REGISTRATION_PERIOD"
+	TransferOutRejectWrittenObjection        = "WRITTEN_OBJECTION"
+	TransferOutRejectTransferredWithin60Days = "TRANSFERRED_WITHIN_SIXTY_DAYS"
+)
+
+// ValidTransferOutRejectReasons returns the reasons accepted by
+// RejectDomainTransferOut.
+func ValidTransferOutRejectReasons() []string {
+	return []string{
+		TransferOutRejectEvidenceOfFraud,
+		TransferOutRejectURDPAction,
+		TransferOutRejectCourtOrder,
+		TransferOutRejectDisputeOverIdentity,
+		TransferOutRejectNoPayment,
+		TransferOutRejectWrittenObjection,
+		TransferOutRejectTransferredWithin60Days,
+	}
+}
+
+// IsPendingTransferOut reports whether a domain status indicates that a
+// transfer to another registrar is waiting for approval.
+func IsPendingTransferOut(status string) bool {
+	status = strings.ToUpper(status)
+	return strings.Contains(status, "TRANSFER_OUT") && strings.Contains(status, "PENDING")
+}
+
+func (c *Client) AcceptDomainTransferOut(ctx context.Context, domain string) error {
+	path, err := c.customerPath("/domains/%s/transferOutAccept", domain)
+	if err != nil {
+		return fmt.Errorf("failed to accept outbound transfer of domain %s: %w", domain, err)
+	}
+
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to accept outbound transfer of domain %s: %w", domain, err)
+	}
+	return nil
+}
+
+func (c *Client) RejectDomainTransferOut(ctx context.Context, domain, reason string) error {
+	path, err := c.customerPath("/domains/%s/transferOutReject", domain)
+	if err != nil {
+		return fmt.Errorf("failed to reject outbound transfer of domain %s: %w", domain, err)
+	}
+
+	path += "?reason=" + url.QueryEscape(reason)
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to reject outbound transfer of domain %s: %w", domain, err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package godaddy
+
+import (
+	"testing"
+)
+
+func TestIsPendingTransferOut(t *testing.T) {
+	for _, status := range []string{"PENDING_TRANSFER_OUT", "TRANSFER_OUT_PENDING", "pending_transfer_out_ack"} {
+		if !IsPendingTransferOut(status) {
+			t.Errorf("IsPendingTransferOut(%q) = false, want true", status)
+		}
+	}
+
+	for _, status := range []string{"ACTIVE", "TRANSFERRED_OUT", "PENDING_TRANSFER", ""} {
+		if IsPendingTransferOut(status) {
+			t.Errorf("IsPendingTransferOut(%q) = true, want false", status)
+		}
+	}
+}
+
+func TestDomainTransferStatusIsFailed(t *testing.T) {
+	for _, status := range []string{"FAILED", "CANCELLED", "REJECTED_BY_REGISTRAR", "DENIED"} {
+		if !(DomainTransferStatus{Status: status}).IsFailed() {
+			t.Errorf("IsFailed() for %q = false, want true", status)
+		}
+	}
+
+	if (DomainTransferStatus{Status: "PENDING"}).IsFailed() {
+		t.Error("IsFailed() for PENDING = true, want false")
+	}
+}
@@ -0,0 +1,55 @@
+package godaddy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultWaitTimeout  = 10 * time.Minute
+	DefaultWaitInterval = 30 * time.Second
+)
+
+// ErrWaitTimeout is returned by WaitFor when the timeout expires before the
+// check reports done.
+var ErrWaitTimeout = errors.New("timed out")
+
+// WaitOptions controls how long and how often WaitFor polls
+type WaitOptions struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// WaitFor calls check until it reports done, returns an error, the timeout
+// expires or ctx is cancelled. check is called once immediately and then
+// every Interval.
+func WaitFor(ctx context.Context, opts WaitOptions, check func(ctx context.Context) (bool, error)) error {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultWaitTimeout
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultWaitInterval
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w after %s", ErrWaitTimeout, opts.Timeout)
+		}
+
+		select {
+		case <-time.After(opts.Interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// contactEmailValidator validates contact email addresses
+type contactEmailValidator struct{}
+
+func (v contactEmailValidator) Description(ctx context.Context) string {
+	return "validates email address syntax"
+}
+
+func (v contactEmailValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that the value is a syntactically valid email address"
+}
+
+func (v contactEmailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := godaddy.ValidateEmail(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			err.Error(),
+		)
+	}
+}
+
+func ContactEmailValidator() validator.String {
+	return contactEmailValidator{}
+}
+
+// contactPhoneValidator validates contact phone and fax numbers
+type contactPhoneValidator struct{}
+
+func (v contactPhoneValidator) Description(ctx context.Context) string {
+	return "validates phone number format"
+}
+
+func (v contactPhoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that the phone number is in E.164 (+15555551234) or GoDaddy (+1.5555551234) format"
+}
+
+func (v contactPhoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if _, err := godaddy.NormalizePhone(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Phone Number",
+			err.Error(),
+		)
+	}
+}
+
+func ContactPhoneValidator() validator.String {
+	return contactPhoneValidator{}
+}
+
+// countryCodeValidator validates ISO 3166-1 alpha-2 country codes
+type countryCodeValidator struct{}
+
+func (v countryCodeValidator) Description(ctx context.Context) string {
+	return "validates country code"
+}
+
+func (v countryCodeValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that the value is an ISO 3166-1 alpha-2 country code"
+}
+
+func (v countryCodeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := godaddy.ValidateCountryCode(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Country Code",
+			err.Error(),
+		)
+	}
+}
+
+func CountryCodeValidator() validator.String {
+	return countryCodeValidator{}
+}
+
+// countryCache memoizes /v1/countries lookups for the lifetime of the
+// provider, since many domains usually share a handful of countries. The lock
+// is not held during lookups, so different countries are fetched in parallel
+// while concurrent lookups of the same country share one request.
+type countryCache struct {
+	mu        sync.Mutex
+	countries map[string]*godaddy.Country
+	pending   map[string]*countryLookup
+}
+
+// countryLookup is a /v1/countries request in flight. done is closed once
+// country and err are set.
+type countryLookup struct {
+	done    chan struct{}
+	country *godaddy.Country
+	err     error
+}
+
+func newCountryCache() *countryCache {
+	return &countryCache{
+		countries: map[string]*godaddy.Country{},
+		pending:   map[string]*countryLookup{},
+	}
+}
+
+func (c *countryCache) get(ctx context.Context, client *godaddy.Client, countryKey string) (*godaddy.Country, error) {
+	key := strings.ToUpper(countryKey)
+
+	c.mu.Lock()
+	if country, ok := c.countries[key]; ok {
+		c.mu.Unlock()
+		return country, nil
+	}
+	if lookup, ok := c.pending[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-lookup.done:
+			return lookup.country, lookup.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	lookup := &countryLookup{done: make(chan struct{})}
+	c.pending[key] = lookup
+	c.mu.Unlock()
+
+	lookup.country, lookup.err = client.GetCountry(ctx, key)
+
+	// Failed lookups are not cached, so the next plan retries them
+	c.mu.Lock()
+	if lookup.err == nil {
+		c.countries[key] = lookup.country
+	}
+	delete(c.pending, key)
+	c.mu.Unlock()
+	close(lookup.done)
+
+	return lookup.country, lookup.err
+}
+
+// contactChecker runs the plan-time contact checks that need the API: the
+// per-country state lists and, when enabled, GoDaddy's contact validation.
+type contactChecker struct {
+	client    *godaddy.Client
+	countries *countryCache
+	remote    bool
+}
+
+func newContactChecker(providerData *GoDaddyProviderData) *contactChecker {
+	return &contactChecker{
+		client:    providerData.Client,
+		countries: providerData.Countries,
+		remote:    providerData.ValidateContacts,
+	}
+}
+
+// check validates the known, non-null contacts keyed by their attribute name.
+func (c *contactChecker) check(ctx context.Context, domain string, contacts map[string]types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || c.client == nil {
+		return diags
+	}
+
+	validation := godaddy.DomainContactsValidation{Domains: []string{domain}}
+	for _, name := range sortedKeys(contacts) {
+		obj := contacts[name]
+		if obj.IsNull() || obj.IsUnknown() || contactHasUnknowns(obj) {
+			continue
+		}
+		contact := godaddy.NormalizeContact(*objectToContact(obj))
+
+		if err := c.checkState(ctx, contact.AddressMailing); err != nil {
+			diags.AddAttributeError(
+				path.Root(name).AtName("state"),
+				"Invalid State",
+				err.Error(),
+			)
+		}
+
+		switch name {
+		case "contact_admin", contactRoleAdmin:
+			validation.ContactAdmin = &contact
+		case "contact_billing", contactRoleBilling:
+			validation.ContactBilling = &contact
+		case "contact_registrant", contactRoleRegistrant:
+			validation.ContactRegistrant = &contact
+		case "contact_tech", contactRoleTech:
+			validation.ContactTech = &contact
+		}
+	}
+
+	if diags.HasError() || !c.remote {
+		return diags
+	}
+
+	if validation.ContactAdmin == nil && validation.ContactBilling == nil &&
+		validation.ContactRegistrant == nil && validation.ContactTech == nil {
+		return diags
+	}
+
+	if err := c.client.ValidateDomainContacts(ctx, validation); err != nil {
+		diags.AddError(
+			"Contacts Rejected by GoDaddy",
+			fmt.Sprintf("GoDaddy would not accept the contacts for %s: %s", domain, err),
+		)
+	}
+
+	return diags
+}
+
+// checkState verifies the state against the country's state list. Countries
+// without a state list accept any value.
+func (c *contactChecker) checkState(ctx context.Context, address godaddy.DomainAddress) error {
+	if godaddy.ValidateCountryCode(address.Country) != nil {
+		// Already reported by the schema validator
+		return nil
+	}
+
+	country, err := c.countries.get(ctx, c.client, address.Country)
+	if err != nil {
+		// The state check is best effort; the update itself will still be
+		// validated by GoDaddy.
+		return nil
+	}
+
+	if len(country.States) == 0 {
+		return nil
+	}
+
+	for _, state := range country.States {
+		if strings.EqualFold(state.StateKey, address.State) || strings.EqualFold(state.Label, address.State) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is not a valid state for country %s", address.State, address.Country)
+}
+
+func sortedKeys(m map[string]types.Object) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// contactHasUnknowns reports whether any attribute of the contact is unknown.
+func contactHasUnknowns(obj types.Object) bool {
+	for _, value := range obj.Attributes() {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// preserveContactFormat returns prior when it describes the same contact as
+// fresh once normalized, so that formatting differences (E.164 phone numbers,
+// lower-case country codes) are not reported as drift.
+func preserveContactFormat(prior types.Object, fresh godaddy.DomainContact, toObject func(godaddy.DomainContact) types.Object) types.Object {
+	if contact := objectToContact(prior); contact != nil && contactsEqual(godaddy.NormalizeContact(*contact), fresh) {
+		return prior
+	}
+	return toObject(fresh)
+}
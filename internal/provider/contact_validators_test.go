@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCountryCache_Get(t *testing.T) {
+	// The US lookup only answers once the CA lookup has started, which
+	// deadlocks if one lookup blocks the others.
+	caStarted := make(chan struct{})
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/v1/countries/")
+		mu.Lock()
+		requests[key]++
+		first := requests[key] == 1
+		mu.Unlock()
+
+		switch key {
+		case "US":
+			select {
+			case <-caStarted:
+			case <-time.After(5 * time.Second):
+				t.Error("the CA lookup did not start while the US lookup was in flight")
+			}
+		case "CA":
+			if first {
+				close(caStarted)
+			}
+		}
+		w.Write([]byte(`{"countryKey":"` + key + `","states":[{"stateKey":"X"}]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := newTestClient(server.URL)
+	cache := newCountryCache()
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"US", "us", "US", "CA"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			country, err := cache.get(ctx, client, key)
+			if err != nil {
+				t.Errorf("get(%s) error = %s", key, err)
+				return
+			}
+			if !strings.EqualFold(country.CountryKey, key) {
+				t.Errorf("get(%s) = %s", key, country.CountryKey)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if _, err := cache.get(ctx, client, "US"); err != nil {
+		t.Fatalf("get(US) error = %s", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if requests["US"] != 1 || requests["CA"] != 1 {
+		t.Errorf("requests = %v, want one request per country", requests)
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// domainActionWaiter blocks until the asynchronous registry actions started
+// by a change have finished, when the provider's wait_for_domain_actions
+// setting is enabled. A nil waiter never waits.
+type domainActionWaiter struct {
+	client *godaddy.Client
+	opts   godaddy.WaitOptions
+}
+
+// wait waits for each of the action types started at or after since and
+// reports failed actions as errors.
+func (w *domainActionWaiter) wait(ctx context.Context, domain string, since time.Time, actionTypes ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if w == nil {
+		return diags
+	}
+
+	for _, actionType := range actionTypes {
+		tflog.Debug(ctx, "Waiting for domain action", map[string]interface{}{
+			"domain": domain,
+			"type":   actionType,
+		})
+
+		if _, err := w.client.WaitForDomainAction(ctx, domain, actionType, since, w.opts); err != nil {
+			var actionErr *godaddy.DomainActionError
+			if errors.As(err, &actionErr) {
+				diags.AddError(
+					"Domain Action Failed",
+					fmt.Sprintf("GoDaddy could not complete the change to %s: %s", domain, err),
+				)
+			} else {
+				diags.AddError(
+					"Error Waiting for Domain Action",
+					fmt.Sprintf("Could not confirm that the change to %s completed: %s", domain, err),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// diagnosticsError turns error diagnostics into an error, for helpers that
+// report failures as errors.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags.Errors() {
+		msgs = append(msgs, d.Detail())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
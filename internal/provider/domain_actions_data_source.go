@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainActionsDataSource{}
+
+func NewDomainActionsDataSource() datasource.DataSource {
+	return &DomainActionsDataSource{}
+}
+
+type DomainActionsDataSource struct {
+	client *godaddy.Client
+}
+
+type DomainActionsDataSourceModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Type    types.String `tfsdk:"type"`
+	Status  types.String `tfsdk:"status"`
+	Actions types.List   `tfsdk:"actions"`
+}
+
+type DomainActionModel struct {
+	Type          types.String `tfsdk:"type"`
+	Origin        types.String `tfsdk:"origin"`
+	Status        types.String `tfsdk:"status"`
+	RequestID     types.String `tfsdk:"request_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	StartedAt     types.String `tfsdk:"started_at"`
+	CompletedAt   types.String `tfsdk:"completed_at"`
+	ModifiedAt    types.String `tfsdk:"modified_at"`
+	ReasonCode    types.String `tfsdk:"reason_code"`
+	ReasonMessage types.String `tfsdk:"reason_message"`
+	ReasonFields  types.List   `tfsdk:"reason_fields"`
+}
+
+func (d *DomainActionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_actions"
+}
+
+func (d *DomainActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for listing the recent asynchronous actions GoDaddy has recorded for a domain. " +
+			"Requires the provider `customer_id`.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to list actions for.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Filter by action type (e.g. `DNSSEC_CREATE`, `DOMAIN_UPDATE_CONTACTS`, `TRANSFER_IN`).",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter by action status: `ACCEPTED`, `AWAITING`, `CANCELLED`, `FAILED`, `PENDING` or `SUCCESS`.",
+				Optional:            true,
+				Validators: []validator.String{
+					StringOneOfValidator(
+						godaddy.ActionStatusAccepted,
+						godaddy.ActionStatusAwaiting,
+						godaddy.ActionStatusCancelled,
+						godaddy.ActionStatusFailed,
+						godaddy.ActionStatusPending,
+						godaddy.ActionStatusSuccess,
+					),
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching actions, most recently created first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The action type.",
+							Computed:            true,
+						},
+						"origin": schema.StringAttribute{
+							MarkdownDescription: "Where the action was started from (e.g. `USER`, `SYSTEM`).",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The action status.",
+							Computed:            true,
+						},
+						"request_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the request that started the action.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was created.",
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: "When processing of the action started.",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "When the action finished.",
+							Computed:            true,
+						},
+						"modified_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was last modified.",
+							Computed:            true,
+						},
+						"reason_code": schema.StringAttribute{
+							MarkdownDescription: "Error code explaining why the action failed.",
+							Computed:            true,
+						},
+						"reason_message": schema.StringAttribute{
+							MarkdownDescription: "Message explaining why the action failed.",
+							Computed:            true,
+						},
+						"reason_fields": schema.ListAttribute{
+							MarkdownDescription: "Request fields the failure relates to.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainActionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainActionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	actions, err := d.client.ListDomainActions(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Actions",
+			fmt.Sprintf("Could not list actions for domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	// Filter by type and status if specified
+	filtered := make([]godaddy.DomainAction, 0, len(actions))
+	for _, action := range actions {
+		if !data.Type.IsNull() && action.Type != data.Type.ValueString() {
+			continue
+		}
+		if !data.Status.IsNull() && action.Status != data.Status.ValueString() {
+			continue
+		}
+		filtered = append(filtered, action)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return actionCreatedAt(filtered[i]).After(actionCreatedAt(filtered[j]))
+	})
+
+	actionModels := make([]DomainActionModel, len(filtered))
+	for i, action := range filtered {
+		actionModels[i] = DomainActionModel{
+			Type:          types.StringValue(action.Type),
+			Origin:        optionalString(action.Origin),
+			Status:        types.StringValue(action.Status),
+			RequestID:     optionalString(action.RequestID),
+			CreatedAt:     optionalTime(action.CreatedAt),
+			StartedAt:     optionalTime(action.StartedAt),
+			CompletedAt:   optionalTime(action.CompletedAt),
+			ModifiedAt:    optionalTime(action.ModifiedAt),
+			ReasonCode:    types.StringNull(),
+			ReasonMessage: types.StringNull(),
+			ReasonFields:  types.ListNull(types.StringType),
+		}
+
+		if reason := action.Reason; reason != nil {
+			actionModels[i].ReasonCode = optionalString(reason.Code)
+			actionModels[i].ReasonMessage = optionalString(reason.Message)
+			if len(reason.Fields) > 0 {
+				fields, diags := types.ListValueFrom(ctx, types.StringType, reason.Fields)
+				resp.Diagnostics.Append(diags...)
+				actionModels[i].ReasonFields = fields
+			}
+		}
+	}
+
+	actionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainActionAttributeTypes()}, actionModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Actions = actionsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func domainActionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":           types.StringType,
+		"origin":         types.StringType,
+		"status":         types.StringType,
+		"request_id":     types.StringType,
+		"created_at":     types.StringType,
+		"started_at":     types.StringType,
+		"completed_at":   types.StringType,
+		"modified_at":    types.StringType,
+		"reason_code":    types.StringType,
+		"reason_message": types.StringType,
+		"reason_fields":  types.ListType{ElemType: types.StringType},
+	}
+}
+
+// actionCreatedAt returns when an action was created, or the zero time
+// when GoDaddy did not report it
+func actionCreatedAt(action godaddy.DomainAction) time.Time {
+	if action.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *action.CreatedAt
+}
+
+// optionalTime formats an optional API timestamp as RFC 3339
+func optionalTime(value *time.Time) types.String {
+	if value == nil || value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
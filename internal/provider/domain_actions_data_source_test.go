@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainActionsDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/customers/cust-1/domains/example.com/actions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[
+			{"type":"DNSSEC_CREATE","status":"FAILED","createdAt":"2025-01-01T00:00:00Z",
+			 "reason":{"code":"INVALID_DS","message":"Digest mismatch","fields":["digest"]}},
+			{"type":"DOMAIN_UPDATE","status":"SUCCESS","createdAt":"2025-01-02T00:00:00Z"},
+			{"type":"DNSSEC_CREATE","status":"SUCCESS","createdAt":"2025-01-03T00:00:00Z","modifiedAt":"2025-01-03T00:05:00Z"}
+		]`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		actionType interface{}
+		status     interface{}
+		wantStatus []string
+	}{
+		{name: "all", wantStatus: []string{"SUCCESS", "SUCCESS", "FAILED"}},
+		{name: "by type", actionType: "DNSSEC_CREATE", wantStatus: []string{"SUCCESS", "FAILED"}},
+		{name: "by type and status", actionType: "DNSSEC_CREATE", status: "FAILED", wantStatus: []string{"FAILED"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := readDataSource(t, &DomainActionsDataSource{client: newTestClient(server.URL)}, map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+				"type":   tftypes.NewValue(tftypes.String, tt.actionType),
+				"status": tftypes.NewValue(tftypes.String, tt.status),
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data DomainActionsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			var actions []DomainActionModel
+			resp.Diagnostics.Append(data.Actions.ElementsAs(ctx, &actions, false)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if len(actions) != len(tt.wantStatus) {
+				t.Fatalf("expected %d actions, got %d", len(tt.wantStatus), len(actions))
+			}
+			for i, want := range tt.wantStatus {
+				if got := actions[i].Status.ValueString(); got != want {
+					t.Errorf("action %d: expected status %s, got %s", i, want, got)
+				}
+			}
+
+			last := actions[len(actions)-1]
+			if last.Status.ValueString() == "FAILED" && last.ReasonCode.ValueString() != "INVALID_DS" {
+				t.Errorf("expected reason code INVALID_DS, got %s", last.ReasonCode)
+			}
+			if last.Status.ValueString() == "SUCCESS" && !last.ReasonCode.IsNull() {
+				t.Errorf("expected no reason for a successful action, got %s", last.ReasonCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainAgreementsDataSource{}
+
+func NewDomainAgreementsDataSource() datasource.DataSource {
+	return &DomainAgreementsDataSource{}
+}
+
+type DomainAgreementsDataSource struct {
+	client *godaddy.Client
+}
+
+type DomainAgreementsDataSourceModel struct {
+	TLDs          types.Set  `tfsdk:"tlds"`
+	Privacy       types.Bool `tfsdk:"privacy"`
+	ForTransfer   types.Bool `tfsdk:"for_transfer"`
+	AgreementKeys types.List `tfsdk:"agreement_keys"`
+	Agreements    types.List `tfsdk:"agreements"`
+}
+
+type DomainAgreementModel struct {
+	AgreementKey types.String `tfsdk:"agreement_key"`
+	Title        types.String `tfsdk:"title"`
+	URL          types.String `tfsdk:"url"`
+}
+
+func (d *DomainAgreementsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_agreements"
+}
+
+func (d *DomainAgreementsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for the legal agreements that must be accepted to purchase domains.",
+		Attributes: map[string]schema.Attribute{
+			"tlds": schema.SetAttribute{
+				MarkdownDescription: "TLDs of the domains being purchased (e.g. `com`, `us`).",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"privacy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domains are purchased with privacy, which adds the privacy agreement. Defaults to `false`.",
+				Optional:            true,
+			},
+			"for_transfer": schema.BoolAttribute{
+				MarkdownDescription: "Return the agreements for transferring the domains in instead of registering them. Defaults to `false`.",
+				Optional:            true,
+			},
+			"agreement_keys": schema.ListAttribute{
+				MarkdownDescription: "Keys of the agreements, ready to use as the agreement keys of a consent.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"agreements": schema.ListNestedAttribute{
+				MarkdownDescription: "The agreements, in the order returned by GoDaddy.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agreement_key": schema.StringAttribute{
+							MarkdownDescription: "Key of the agreement, such as `DNRA`.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the agreement.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Where the agreement can be read, if GoDaddy publishes it.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainAgreementsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainAgreementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainAgreementsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tlds []string
+	resp.Diagnostics.Append(data.TLDs.ElementsAs(ctx, &tlds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(tlds) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tlds"),
+			"Missing TLDs",
+			"At least one TLD is required to look up domain agreements.",
+		)
+		return
+	}
+	for i, tld := range tlds {
+		tlds[i] = strings.TrimPrefix(strings.ToLower(tld), ".")
+	}
+
+	opts := godaddy.AgreementOptions{
+		Privacy:     data.Privacy.ValueBool(),
+		ForTransfer: data.ForTransfer.ValueBool(),
+	}
+	agreements, err := d.client.GetLegalAgreements(ctx, tlds, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Agreements",
+			fmt.Sprintf("Could not get the legal agreements for %s: %s", strings.Join(tlds, ", "), err),
+		)
+		return
+	}
+
+	keys := make([]string, len(agreements))
+	models := make([]DomainAgreementModel, len(agreements))
+	for i, agreement := range agreements {
+		keys[i] = agreement.AgreementKey
+		models[i] = DomainAgreementModel{
+			AgreementKey: types.StringValue(agreement.AgreementKey),
+			Title:        types.StringValue(agreement.Title),
+			URL:          optionalString(agreement.URL),
+		}
+	}
+
+	keysList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	agreementsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainAgreementAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AgreementKeys = keysList
+	data.Agreements = agreementsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func domainAgreementAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"agreement_key": types.StringType,
+		"title":         types.StringType,
+		"url":           types.StringType,
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &DomainAuthCodeEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DomainAuthCodeEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &DomainAuthCodeEphemeralResource{}
+
+// authCodeLockKey is the private data key holding the lock state of a domain
+// unlocked by Open, so Close can restore it.
+const authCodeLockKey = "lock"
+
+func NewDomainAuthCodeEphemeralResource() ephemeral.EphemeralResource {
+	return &DomainAuthCodeEphemeralResource{}
+}
+
+type DomainAuthCodeEphemeralResource struct {
+	client *godaddy.Client
+}
+
+type DomainAuthCodeEphemeralResourceModel struct {
+	Domain   types.String `tfsdk:"domain"`
+	Unlock   types.Bool   `tfsdk:"unlock"`
+	AuthCode types.String `tfsdk:"auth_code"`
+	Locked   types.Bool   `tfsdk:"locked"`
+}
+
+// authCodeLockState is the lock state a domain had before Open unlocked it
+type authCodeLockState struct {
+	Domain string `json:"domain"`
+	Locked bool   `json:"locked"`
+}
+
+func (r *DomainAuthCodeEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code"
+}
+
+func (r *DomainAuthCodeEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the transfer authorization (EPP) code of a domain without storing it in state. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Required:            true,
+			},
+			"unlock": schema.BoolAttribute{
+				MarkdownDescription: "Unlock the domain while the auth code is in use and lock it again afterwards. Defaults to `false`.",
+				Optional:            true,
+			},
+			"auth_code": schema.StringAttribute{
+				MarkdownDescription: "The transfer authorization code.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"locked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain was locked for transfer when the auth code was read.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DomainAuthCodeEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DomainAuthCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DomainAuthCodeEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.Domain.ValueString()
+	domain, err := r.client.GetDomain(ctx, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", domainName, err),
+		)
+		return
+	}
+
+	if domain.AuthCode == "" {
+		resp.Diagnostics.AddError(
+			"Auth Code Not Available",
+			fmt.Sprintf("GoDaddy did not return an auth code for %s. The domain may not be eligible for transfer yet.", domainName),
+		)
+		return
+	}
+
+	data.AuthCode = types.StringValue(domain.AuthCode)
+	data.Locked = types.BoolValue(domain.Locked)
+
+	tflog.Debug(ctx, "Read domain auth code", map[string]interface{}{"domain": domainName})
+
+	if data.Unlock.ValueBool() && domain.Locked {
+		prior, err := json.Marshal(authCodeLockState{Domain: domainName, Locked: domain.Locked})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Saving Private Data",
+				fmt.Sprintf("Could not record the lock state of domain %s: %s", domainName, err),
+			)
+			return
+		}
+		// Record the prior state first so the domain is never left unlocked
+		// without Close knowing about it
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, authCodeLockKey, prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		locked := false
+		if err := r.client.UpdateDomain(ctx, domainName, godaddy.DomainUpdate{Locked: &locked}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Unlocking Domain",
+				fmt.Sprintf("Could not unlock domain %s: %s", domainName, err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Unlocked domain for transfer", map[string]interface{}{"domain": domainName})
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *DomainAuthCodeEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, authCodeLockKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(raw) == 0 {
+		return
+	}
+
+	var prior authCodeLockState
+	if err := json.Unmarshal(raw, &prior); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Private Data",
+			fmt.Sprintf("Could not determine which domain to lock again: %s", err),
+		)
+		return
+	}
+	if !prior.Locked {
+		return
+	}
+
+	locked := true
+	if err := r.client.UpdateDomain(ctx, prior.Domain, godaddy.DomainUpdate{Locked: &locked}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Locking Domain",
+			fmt.Sprintf("Could not lock domain %s again: %s", prior.Domain, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Locked domain again", map[string]interface{}{"domain": prior.Domain})
+}
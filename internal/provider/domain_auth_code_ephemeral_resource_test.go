@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainAuthCodeEphemeralResource_Open(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode string
+		wantErr  bool
+	}{
+		{name: "locked", body: `{"domain":"example.com","authCode":"secret-code","locked":true}`, wantCode: "secret-code"},
+		{name: "no auth code", body: `{"domain":"example.com","locked":true}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Without unlock, Open only reads the domain
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v1/domains/example.com" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			r := &DomainAuthCodeEphemeralResource{client: newTestClient(server.URL)}
+			resp := openEphemeralResource(t, r, map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+			})
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("Open() expected an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Open() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var data DomainAuthCodeEphemeralResourceModel
+			resp.Diagnostics.Append(resp.Result.Get(context.Background(), &data)...)
+			if data.AuthCode.ValueString() != tt.wantCode {
+				t.Errorf("auth_code = %s, want %s", data.AuthCode, tt.wantCode)
+			}
+			if !data.Locked.ValueBool() {
+				t.Error("locked = false, want the domain's lock")
+			}
+		})
+	}
+}
+
+func TestDomainAuthCodeEphemeralResource_UnlockRelock(t *testing.T) {
+	tests := []struct {
+		name        string
+		locked      bool
+		unlock      bool
+		wantOpen    []string
+		wantClosed  []string
+		wantPrivate bool
+	}{
+		{name: "unlock locked domain", locked: true, unlock: true,
+			wantOpen: []string{"locked=false"}, wantClosed: []string{"locked=false", "locked=true"}, wantPrivate: true},
+		{name: "unlock unlocked domain", unlock: true},
+		{name: "without unlock", locked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var updates []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/domains/example.com":
+					fmt.Fprintf(w, `{"domain":"example.com","authCode":"secret-code","locked":%t}`, tt.locked)
+				case r.Method == http.MethodPatch && r.URL.Path == "/v1/domains/example.com":
+					var update godaddy.DomainUpdate
+					json.NewDecoder(r.Body).Decode(&update)
+					if update.Locked == nil {
+						t.Error("domain update without a lock state")
+						return
+					}
+					updates = append(updates, fmt.Sprintf("locked=%t", *update.Locked))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			var schemaResp ephemeral.SchemaResponse
+			(&DomainAuthCodeEphemeralResource{}).Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			configValue := newTestObject(t, schemaResp.Schema.Type(), map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+				"unlock": tftypes.NewValue(tftypes.Bool, tt.unlock),
+			})
+			config, err := tfprotov6.NewDynamicValue(configValue.Type(), configValue)
+			if err != nil {
+				t.Fatalf("could not encode config: %s", err)
+			}
+
+			provider := newTestProviderServer(t, server.URL)
+			opened, err := provider.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+				TypeName: "godaddy_domain_auth_code",
+				Config:   &config,
+			})
+			if err != nil || len(opened.Diagnostics) > 0 {
+				t.Fatalf("Open() = %v, %v", opened.Diagnostics, err)
+			}
+			if !reflect.DeepEqual(updates, tt.wantOpen) {
+				t.Errorf("updates after Open() = %v, want %v", updates, tt.wantOpen)
+			}
+			if (len(opened.Private) > 0) != tt.wantPrivate {
+				t.Errorf("private data = %s, want private data %v", opened.Private, tt.wantPrivate)
+			}
+
+			closed, err := provider.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+				TypeName: "godaddy_domain_auth_code",
+				Private:  opened.Private,
+			})
+			if err != nil || len(closed.Diagnostics) > 0 {
+				t.Fatalf("Close() = %v, %v", closed.Diagnostics, err)
+			}
+			if !reflect.DeepEqual(updates, tt.wantClosed) {
+				t.Errorf("updates after Close() = %v, want %v", updates, tt.wantClosed)
+			}
+		})
+	}
+}
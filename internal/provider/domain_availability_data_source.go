@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainAvailabilityDataSource{}
+
+func NewDomainAvailabilityDataSource() datasource.DataSource {
+	return &DomainAvailabilityDataSource{}
+}
+
+type DomainAvailabilityDataSource struct {
+	client *godaddy.Client
+}
+
+type DomainAvailabilityDataSourceModel struct {
+	Domains          types.List   `tfsdk:"domains"`
+	CheckType        types.String `tfsdk:"check_type"`
+	Results          types.List   `tfsdk:"results"`
+	AvailableDomains types.List   `tfsdk:"available_domains"`
+}
+
+type DomainAvailabilityModel struct {
+	Domain       types.String  `tfsdk:"domain"`
+	Available    types.Bool    `tfsdk:"available"`
+	Definitive   types.Bool    `tfsdk:"definitive"`
+	Price        types.Float64 `tfsdk:"price"`
+	Currency     types.String  `tfsdk:"currency"`
+	Period       types.Int32   `tfsdk:"period"`
+	ErrorCode    types.String  `tfsdk:"error_code"`
+	ErrorMessage types.String  `tfsdk:"error_message"`
+}
+
+func (d *DomainAvailabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_availability"
+}
+
+func (d *DomainAvailabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for checking whether domains can be registered, and at what price.",
+		Attributes: map[string]schema.Attribute{
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "The domain names to check.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"check_type": schema.StringAttribute{
+				MarkdownDescription: "`FAST` answers quickly but may not be definitive; `FULL` always asks the registry. Defaults to `FAST`.",
+				Optional:            true,
+				Validators: []validator.String{
+					StringOneOfValidator(godaddy.CheckTypeFast, godaddy.CheckTypeFull),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Availability of each domain, in the order of `domains`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain name.",
+							Computed:            true,
+						},
+						"available": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain can be registered.",
+							Computed:            true,
+						},
+						"definitive": schema.BoolAttribute{
+							MarkdownDescription: "Whether the answer was confirmed with the registry.",
+							Computed:            true,
+						},
+						"price": schema.Float64Attribute{
+							MarkdownDescription: "Registration price for `period` years, in currency units (e.g. 11.99).",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Currency of `price`.",
+							Computed:            true,
+						},
+						"period": schema.Int32Attribute{
+							MarkdownDescription: "Number of years `price` is for.",
+							Computed:            true,
+						},
+						"error_code": schema.StringAttribute{
+							MarkdownDescription: "Why the domain could not be checked, such as `INVALID_DOMAIN`.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							MarkdownDescription: "Description of the error.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"available_domains": schema.ListAttribute{
+				MarkdownDescription: "The checked domains that can be registered.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DomainAvailabilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainAvailabilityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domains []string
+	resp.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := godaddy.AvailabilityOptions{CheckType: data.CheckType.ValueString()}
+	bulk, err := d.client.CheckDomainsAvailability(ctx, domains, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Checking Domain Availability",
+			fmt.Sprintf("Could not check availability of %d domain(s): %s", len(domains), err),
+		)
+		return
+	}
+
+	// GoDaddy may return the domains in another order or case
+	found := make(map[string]godaddy.DomainAvailability, len(bulk.Domains))
+	for _, availability := range bulk.Domains {
+		found[strings.ToLower(availability.Domain)] = availability
+	}
+	failed := make(map[string]godaddy.DomainAvailabilityError, len(bulk.Errors))
+	for _, checkErr := range bulk.Errors {
+		failed[strings.ToLower(checkErr.Domain)] = checkErr
+	}
+
+	results := make([]DomainAvailabilityModel, len(domains))
+	available := []string{}
+	for i, domain := range domains {
+		result := DomainAvailabilityModel{
+			Domain:       types.StringValue(domain),
+			Available:    types.BoolValue(false),
+			Definitive:   types.BoolValue(false),
+			Price:        types.Float64Null(),
+			Currency:     types.StringNull(),
+			Period:       types.Int32Null(),
+			ErrorCode:    types.StringNull(),
+			ErrorMessage: types.StringNull(),
+		}
+
+		if availability, ok := found[strings.ToLower(domain)]; ok {
+			result.Available = types.BoolValue(availability.Available)
+			result.Definitive = types.BoolValue(availability.Definitive)
+			if availability.Price > 0 {
+				result.Price = types.Float64Value(godaddy.FromMicros(int64(availability.Price)))
+				result.Currency = optionalString(availability.Currency)
+			}
+			if availability.Period > 0 {
+				result.Period = types.Int32Value(int32(availability.Period))
+			}
+			if availability.Available {
+				available = append(available, domain)
+			}
+		} else if checkErr, ok := failed[strings.ToLower(domain)]; ok {
+			result.ErrorCode = optionalString(checkErr.Code)
+			result.ErrorMessage = optionalString(checkErr.Message)
+		} else {
+			result.ErrorCode = types.StringValue("NOT_CHECKED")
+			result.ErrorMessage = types.StringValue("GoDaddy did not return a result for this domain")
+		}
+
+		results[i] = result
+	}
+
+	resultsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainAvailabilityAttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	availableList, diags := types.ListValueFrom(ctx, types.StringType, available)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Results = resultsList
+	data.AvailableDomains = availableList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func domainAvailabilityAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain":        types.StringType,
+		"available":     types.BoolType,
+		"definitive":    types.BoolType,
+		"price":         types.Float64Type,
+		"currency":      types.StringType,
+		"period":        types.Int32Type,
+		"error_code":    types.StringType,
+		"error_message": types.StringType,
+	}
+}
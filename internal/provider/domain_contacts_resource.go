@@ -0,0 +1,542 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainContactsResource{}
+var _ resource.ResourceWithImportState = &DomainContactsResource{}
+var _ resource.ResourceWithValidateConfig = &DomainContactsResource{}
+var _ resource.ResourceWithModifyPlan = &DomainContactsResource{}
+
+// Contact roles, in the order they are resolved and reported
+const (
+	contactRoleRegistrant = "registrant"
+	contactRoleAdmin      = "admin"
+	contactRoleTech       = "tech"
+	contactRoleBilling    = "billing"
+)
+
+var contactRoles = []string{contactRoleRegistrant, contactRoleAdmin, contactRoleTech, contactRoleBilling}
+
+func NewDomainContactsResource() resource.Resource {
+	return &DomainContactsResource{}
+}
+
+type DomainContactsResource struct {
+	client         *godaddy.Client
+	contactChecker *contactChecker
+	actions        *domainActionWaiter
+}
+
+type DomainContactsResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Domain     types.String `tfsdk:"domain"`
+	Registrant types.Object `tfsdk:"registrant"`
+	Admin      types.Object `tfsdk:"admin"`
+	Tech       types.Object `tfsdk:"tech"`
+	Billing    types.Object `tfsdk:"billing"`
+	CopyFrom   types.Map    `tfsdk:"copy_from"`
+}
+
+// role returns a pointer to the model field holding the given contact role.
+func (m *DomainContactsResourceModel) role(name string) *types.Object {
+	switch name {
+	case contactRoleRegistrant:
+		return &m.Registrant
+	case contactRoleAdmin:
+		return &m.Admin
+	case contactRoleTech:
+		return &m.Tech
+	case contactRoleBilling:
+		return &m.Billing
+	}
+	return nil
+}
+
+func (r *DomainContactsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_contacts"
+}
+
+func (r *DomainContactsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the WHOIS contacts of a GoDaddy domain. Each role can be managed on its own, " +
+			"copied from another role with `copy_from`, or left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registrant": schema.SingleNestedAttribute{
+				MarkdownDescription: "Registrant contact. Changing the name, organization or email is an ICANN change of registrant.",
+				Optional:            true,
+				Computed:            true,
+				Attributes:          contactAttributes(),
+			},
+			"admin": schema.SingleNestedAttribute{
+				MarkdownDescription: "Administrative contact.",
+				Optional:            true,
+				Computed:            true,
+				Attributes:          contactAttributes(),
+			},
+			"tech": schema.SingleNestedAttribute{
+				MarkdownDescription: "Technical contact.",
+				Optional:            true,
+				Computed:            true,
+				Attributes:          contactAttributes(),
+			},
+			"billing": schema.SingleNestedAttribute{
+				MarkdownDescription: "Billing contact.",
+				Optional:            true,
+				Computed:            true,
+				Attributes:          contactAttributes(),
+			},
+			"copy_from": schema.MapAttribute{
+				MarkdownDescription: "Roles to copy from another role, keyed by target role. For example " +
+					"`{ tech = \"registrant\" }` keeps the technical contact identical to the registrant. " +
+					"Valid roles are `registrant`, `admin`, `tech` and `billing`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DomainContactsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainContactsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.CopyFrom.IsNull() || data.CopyFrom.IsUnknown() {
+		return
+	}
+
+	copyFrom := map[string]string{}
+	resp.Diagnostics.Append(data.CopyFrom.ElementsAs(ctx, &copyFrom, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for target, source := range copyFrom {
+		if data.role(target) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("copy_from").AtMapKey(target),
+				"Invalid Contact Role",
+				fmt.Sprintf("%q is not a contact role. Valid roles are: %s", target, strings.Join(contactRoles, ", ")),
+			)
+			continue
+		}
+		if data.role(source) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("copy_from").AtMapKey(target),
+				"Invalid Contact Role",
+				fmt.Sprintf("%q is not a contact role. Valid roles are: %s", source, strings.Join(contactRoles, ", ")),
+			)
+			continue
+		}
+		if target == source {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("copy_from").AtMapKey(target),
+				"Invalid Contact Copy",
+				fmt.Sprintf("The %s contact cannot be copied from itself.", target),
+			)
+		}
+		if !data.role(target).IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("copy_from").AtMapKey(target),
+				"Conflicting Contact Configuration",
+				fmt.Sprintf("The %s contact is configured explicitly and cannot also be copied from %s.", target, source),
+			)
+		}
+		if _, chained := copyFrom[source]; chained {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("copy_from").AtMapKey(target),
+				"Invalid Contact Copy",
+				fmt.Sprintf("The %s contact is copied from %s, which is itself copied. Copy from %s's source directly.", target, source, source),
+			)
+		}
+	}
+}
+
+func (r *DomainContactsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan DomainContactsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *DomainContactsResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &DomainContactsResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	copyFrom := map[string]string{}
+	if !config.CopyFrom.IsNull() && !config.CopyFrom.IsUnknown() {
+		resp.Diagnostics.Append(config.CopyFrom.ElementsAs(ctx, &copyFrom, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The registry contacts are only fetched when the plan needs them.
+	var current *godaddy.DomainContactsResponse
+	currentContacts := func() *godaddy.DomainContactsResponse {
+		if current != nil || r.client == nil || plan.Domain.IsUnknown() {
+			return current
+		}
+		contacts, err := r.client.GetDomainContacts(ctx, plan.Domain.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "Could not read current domain contacts during plan", map[string]interface{}{
+				"domain": plan.Domain.ValueString(),
+				"error":  err.Error(),
+			})
+			return nil
+		}
+		current = contacts
+		return current
+	}
+
+	for _, role := range contactRoles {
+		planned := plan.role(role)
+		configured := config.role(role)
+
+		source, copied := copyFrom[role]
+		switch {
+		case !configured.IsNull():
+			*planned = *configured
+		case copied && !config.role(source).IsNull():
+			*planned = *config.role(source)
+		case copied && state != nil && !state.role(source).IsNull():
+			*planned = *state.role(source)
+		case copied:
+			if contacts := currentContacts(); contacts != nil {
+				*planned = contactToObjectWithNulls(contactForRole(*contacts, source))
+			} else {
+				*planned = types.ObjectUnknown(contactAttributeTypes())
+			}
+		default:
+			*planned = types.ObjectNull(contactAttributeTypes())
+		}
+	}
+
+	if !plan.Domain.IsUnknown() {
+		contacts := map[string]types.Object{}
+		for _, role := range contactRoles {
+			if !plan.role(role).IsNull() {
+				contacts[role] = *plan.role(role)
+			}
+		}
+		resp.Diagnostics.Append(r.contactChecker.check(ctx, plan.Domain.ValueString(), contacts)...)
+	}
+
+	// Warn about the consequences of an ICANN change of registrant.
+	if !plan.Registrant.IsNull() && !plan.Registrant.IsUnknown() && !registrantIdentityUnknown(plan.Registrant) {
+		var previous *godaddy.DomainContact
+		if state != nil && !state.Registrant.IsNull() {
+			previous = objectToContact(state.Registrant)
+		} else if contacts := currentContacts(); contacts != nil {
+			previous = &contacts.ContactRegistrant
+		}
+
+		if previous != nil && isChangeOfRegistrant(*previous, *objectToContact(plan.Registrant)) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("registrant"),
+				"Change of Registrant",
+				fmt.Sprintf("This plan changes the registrant name, organization or email of %s. "+
+					"Under ICANN transfer policy this is a change of registrant: GoDaddy will ask the current and "+
+					"new registrant to confirm it, and the domain will be locked against transfers to another "+
+					"registrar for 60 days once it completes.", plan.Domain.ValueString()),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *DomainContactsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.contactChecker = newContactChecker(providerData)
+	r.actions = providerData.Actions
+}
+
+func (r *DomainContactsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainContactsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyContacts(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString())
+
+	tflog.Trace(ctx, "created domain contacts resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainContactsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainContactsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contacts, err := r.client.GetDomainContacts(ctx, data.Domain.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Contacts",
+			fmt.Sprintf("Could not read contacts for domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	// Only roles that are managed (set in state) are refreshed, so drift is
+	// reported for them and unmanaged roles stay out of the state.
+	for _, role := range contactRoles {
+		if !data.role(role).IsNull() {
+			*data.role(role) = preserveContactFormat(*data.role(role), contactForRole(*contacts, role), contactToObjectWithNulls)
+		}
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainContactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainContactsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyContacts(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainContactsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainContactsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Note: a domain always has contacts, so they are left as they are
+	tflog.Warn(ctx, "Domain contacts removed from Terraform state but remain unchanged in GoDaddy",
+		map[string]interface{}{"domain": data.Domain.ValueString()})
+}
+
+func (r *DomainContactsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	contacts, err := r.client.GetDomainContacts(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Contacts for Import",
+			fmt.Sprintf("Could not read contacts for domain %s: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), req.ID)...)
+	for _, role := range contactRoles {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(role), contactToObjectWithNulls(contactForRole(*contacts, role)))...)
+	}
+}
+
+// applyContacts sends every planned role that differs from the registry in a
+// single contacts update.
+func (r *DomainContactsResource) applyContacts(ctx context.Context, model *DomainContactsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetDomainContacts(ctx, model.Domain.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading Domain Contacts",
+			fmt.Sprintf("Could not read contacts for domain %s: %s", model.Domain.ValueString(), err),
+		)
+		return diags
+	}
+
+	// Copies whose source could not be read at plan time are resolved now.
+	copyFrom := map[string]string{}
+	if !model.CopyFrom.IsNull() {
+		diags.Append(model.CopyFrom.ElementsAs(ctx, &copyFrom, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	for target, source := range copyFrom {
+		if !model.role(target).IsUnknown() {
+			continue
+		}
+		if src := model.role(source); !src.IsNull() && !src.IsUnknown() {
+			*model.role(target) = *src
+		} else {
+			*model.role(target) = contactToObjectWithNulls(contactForRole(*current, source))
+		}
+	}
+
+	update := godaddy.DomainContacts{}
+	var changed []string
+	for _, role := range contactRoles {
+		contact := normalizedContact(*model.role(role))
+		if contact == nil || contactsEqual(*contact, contactForRole(*current, role)) {
+			continue
+		}
+		setContactForRole(&update, role, contact)
+		changed = append(changed, role)
+	}
+
+	if len(changed) == 0 {
+		return diags
+	}
+
+	sort.Strings(changed)
+	tflog.Debug(ctx, "Updating domain contacts", map[string]interface{}{
+		"domain": model.Domain.ValueString(),
+		"roles":  strings.Join(changed, ","),
+	})
+
+	since := time.Now()
+	if err := r.client.UpdateDomainContacts(ctx, model.Domain.ValueString(), update); err != nil {
+		diags.AddError(
+			"Error Updating Domain Contacts",
+			fmt.Sprintf("Could not update contacts for domain %s: %s", model.Domain.ValueString(), err),
+		)
+		return diags
+	}
+
+	diags.Append(r.actions.wait(ctx, model.Domain.ValueString(), since, godaddy.ActionDomainUpdateContacts)...)
+	return diags
+}
+
+func contactForRole(contacts godaddy.DomainContactsResponse, role string) godaddy.DomainContact {
+	switch role {
+	case contactRoleAdmin:
+		return contacts.ContactAdmin
+	case contactRoleTech:
+		return contacts.ContactTech
+	case contactRoleBilling:
+		return contacts.ContactBilling
+	default:
+		return contacts.ContactRegistrant
+	}
+}
+
+func setContactForRole(contacts *godaddy.DomainContacts, role string, contact *godaddy.DomainContact) {
+	switch role {
+	case contactRoleAdmin:
+		contacts.ContactAdmin = contact
+	case contactRoleTech:
+		contacts.ContactTech = contact
+	case contactRoleBilling:
+		contacts.ContactBilling = contact
+	default:
+		contacts.ContactRegistrant = contact
+	}
+}
+
+// isChangeOfRegistrant reports whether going from a to b is a "material
+// change" under the ICANN transfer policy.
+func isChangeOfRegistrant(a, b godaddy.DomainContact) bool {
+	return !strings.EqualFold(strings.TrimSpace(a.NameFirst), strings.TrimSpace(b.NameFirst)) ||
+		!strings.EqualFold(strings.TrimSpace(a.NameLast), strings.TrimSpace(b.NameLast)) ||
+		!strings.EqualFold(strings.TrimSpace(a.Organization), strings.TrimSpace(b.Organization)) ||
+		!strings.EqualFold(strings.TrimSpace(a.Email), strings.TrimSpace(b.Email))
+}
+
+// registrantIdentityUnknown reports whether any field that makes up a change
+// of registrant is still unknown.
+func registrantIdentityUnknown(obj types.Object) bool {
+	attrs := obj.Attributes()
+	for _, name := range []string{"name_first", "name_last", "organization", "email"} {
+		if attrs[name].IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// contactToObjectWithNulls is like contactToObject but leaves empty optional
+// fields null, so they match a configuration that omits them.
+func contactToObjectWithNulls(contact godaddy.DomainContact) types.Object {
+	attributes := map[string]attr.Value{
+		"name_first":   types.StringValue(contact.NameFirst),
+		"name_middle":  optionalString(contact.NameMiddle),
+		"name_last":    types.StringValue(contact.NameLast),
+		"organization": optionalString(contact.Organization),
+		"job_title":    optionalString(contact.JobTitle),
+		"email":        types.StringValue(contact.Email),
+		"phone":        types.StringValue(contact.Phone),
+		"fax":          optionalString(contact.Fax),
+		"address1":     types.StringValue(contact.AddressMailing.Address1),
+		"address2":     optionalString(contact.AddressMailing.Address2),
+		"city":         types.StringValue(contact.AddressMailing.City),
+		"state":        types.StringValue(contact.AddressMailing.State),
+		"postal_code":  types.StringValue(contact.AddressMailing.PostalCode),
+		"country":      types.StringValue(contact.AddressMailing.Country),
+	}
+
+	return types.ObjectValueMust(contactAttributeTypes(), attributes)
+}
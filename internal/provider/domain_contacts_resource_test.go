@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testContact(first, email string) godaddy.DomainContact {
+	return godaddy.DomainContact{
+		NameFirst: first,
+		NameLast:  "Doe",
+		Email:     email,
+		Phone:     "+1.5555551234",
+		AddressMailing: godaddy.DomainAddress{
+			Address1:   "1 Main St",
+			City:       "Springfield",
+			State:      "IL",
+			PostalCode: "62701",
+			Country:    "US",
+		},
+	}
+}
+
+// fakeContactsServer serves the contacts of example.com and records the
+// contacts update sent to it, if any.
+func fakeContactsServer(t *testing.T, contacts godaddy.DomainContactsResponse, update *godaddy.DomainContacts) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/example.com/contacts" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(contacts)
+		case http.MethodPatch:
+			if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+				t.Errorf("could not decode contacts update: %s", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func contactValue(t *testing.T, contact godaddy.DomainContact) tftypes.Value {
+	t.Helper()
+	value, err := contactToObjectWithNulls(contact).ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("could not convert contact: %s", err)
+	}
+	return value
+}
+
+func TestIsChangeOfRegistrant(t *testing.T) {
+	jane := testContact("Jane", "jane@example.com")
+
+	tests := []struct {
+		name   string
+		change func(*godaddy.DomainContact)
+		want   bool
+	}{
+		{name: "identical", change: func(c *godaddy.DomainContact) {}, want: false},
+		{name: "case and whitespace", change: func(c *godaddy.DomainContact) { c.Email = " JANE@example.com " }, want: false},
+		{name: "phone and address", change: func(c *godaddy.DomainContact) {
+			c.Phone = "+1.5555550000"
+			c.AddressMailing.City = "Shelbyville"
+		}, want: false},
+		{name: "first name", change: func(c *godaddy.DomainContact) { c.NameFirst = "Janet" }, want: true},
+		{name: "last name", change: func(c *godaddy.DomainContact) { c.NameLast = "Roe" }, want: true},
+		{name: "organization", change: func(c *godaddy.DomainContact) { c.Organization = "Example Inc" }, want: true},
+		{name: "email", change: func(c *godaddy.DomainContact) { c.Email = "jane@example.org" }, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := jane
+			tt.change(&changed)
+			if got := isChangeOfRegistrant(jane, changed); got != tt.want {
+				t.Errorf("isChangeOfRegistrant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainContactsResource_PlanCopyFrom(t *testing.T) {
+	registrant := testContact("Jane", "jane@example.com")
+	admin := testContact("Admin", "admin@example.com")
+	server := fakeContactsServer(t, godaddy.DomainContactsResponse{
+		ContactRegistrant: registrant,
+		ContactAdmin:      admin,
+		ContactTech:       testContact("Tech", "tech@example.com"),
+		ContactBilling:    registrant,
+	}, nil)
+
+	copyFrom := func(target, source string) tftypes.Value {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			target: tftypes.NewValue(tftypes.String, source),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		client   *godaddy.Client
+		config   map[string]tftypes.Value
+		wantTech *godaddy.DomainContact
+	}{
+		{
+			name: "from a configured role",
+			config: map[string]tftypes.Value{
+				"registrant": contactValue(t, testContact("New", "new@example.com")),
+				"copy_from":  copyFrom("tech", "registrant"),
+			},
+			wantTech: &godaddy.DomainContact{NameFirst: "New", Email: "new@example.com"},
+		},
+		{
+			name:     "from an unmanaged role",
+			client:   newTestClient(server.URL),
+			config:   map[string]tftypes.Value{"copy_from": copyFrom("tech", "admin")},
+			wantTech: &admin,
+		},
+		{
+			name:   "from an unmanaged role without the API",
+			config: map[string]tftypes.Value{"copy_from": copyFrom("tech", "admin")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &DomainContactsResource{client: tt.client}
+
+			tt.config["domain"] = tftypes.NewValue(tftypes.String, "example.com")
+			resp := planResource(t, r, tfsdk.State{}, tt.config)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var plan DomainContactsResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if tt.wantTech == nil {
+				if !plan.Tech.IsUnknown() {
+					t.Errorf("tech = %s, want unknown", plan.Tech)
+				}
+				return
+			}
+			tech := objectToContact(plan.Tech)
+			if tech == nil || tech.NameFirst != tt.wantTech.NameFirst || tech.Email != tt.wantTech.Email {
+				t.Errorf("tech = %s, want a copy of %s", plan.Tech, tt.wantTech.Email)
+			}
+			if !plan.Admin.IsNull() || !plan.Billing.IsNull() {
+				t.Errorf("admin = %s, billing = %s, want unmanaged roles to stay null", plan.Admin, plan.Billing)
+			}
+		})
+	}
+}
+
+func TestDomainContactsResource_CreateResolvesUnknownCopy(t *testing.T) {
+	admin := testContact("Admin", "admin@example.com")
+	var update godaddy.DomainContacts
+	server := fakeContactsServer(t, godaddy.DomainContactsResponse{
+		ContactRegistrant: testContact("Jane", "jane@example.com"),
+		ContactAdmin:      admin,
+		ContactTech:       testContact("Tech", "tech@example.com"),
+		ContactBilling:    admin,
+	}, &update)
+
+	// Without the API at plan time, the copy is left unknown until apply
+	r := &DomainContactsResource{}
+	plan := planResource(t, r, tfsdk.State{}, map[string]tftypes.Value{
+		"domain": tftypes.NewValue(tftypes.String, "example.com"),
+		"copy_from": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"tech": tftypes.NewValue(tftypes.String, "admin"),
+		}),
+	}).Plan
+
+	r.client = newTestClient(server.URL)
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+	}
+
+	if update.ContactTech == nil || update.ContactTech.Email != admin.Email {
+		t.Errorf("update tech = %v, want the admin contact", update.ContactTech)
+	}
+	if update.ContactRegistrant != nil || update.ContactAdmin != nil || update.ContactBilling != nil {
+		t.Errorf("update = %+v, want only the tech contact", update)
+	}
+
+	var data DomainContactsResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if tech := objectToContact(data.Tech); tech == nil || tech.Email != admin.Email {
+		t.Errorf("tech = %s, want the admin contact", data.Tech)
+	}
+}
+
+func TestDomainContactsResource_PlanChangeOfRegistrant(t *testing.T) {
+	jane := testContact("Jane", "jane@example.com")
+
+	tests := []struct {
+		name        string
+		registrant  godaddy.DomainContact
+		wantWarning bool
+	}{
+		{name: "new phone number", registrant: func() godaddy.DomainContact {
+			c := jane
+			c.Phone = "+1.5555550000"
+			return c
+		}(), wantWarning: false},
+		{name: "new email", registrant: testContact("Jane", "jane@example.org"), wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainContactsResource{}
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "example.com"),
+				"domain":     tftypes.NewValue(tftypes.String, "example.com"),
+				"registrant": contactValue(t, jane),
+			})
+
+			resp := planResource(t, r, state, map[string]tftypes.Value{
+				"domain":     tftypes.NewValue(tftypes.String, "example.com"),
+				"registrant": contactValue(t, tt.registrant),
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			warned := false
+			for _, d := range resp.Diagnostics.Warnings() {
+				if d.Summary() == "Change of Registrant" {
+					warned = true
+				}
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("change of registrant warning = %v, want %v", warned, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestDomainContactsResource_ImportRead(t *testing.T) {
+	ctx := context.Background()
+	registrant := testContact("Jane", "jane@example.com")
+	registrant.Organization = "Example Inc"
+	server := fakeContactsServer(t, godaddy.DomainContactsResponse{
+		ContactRegistrant: registrant,
+		ContactAdmin:      testContact("Admin", "admin@example.com"),
+		ContactTech:       testContact("Tech", "tech@example.com"),
+		ContactBilling:    registrant,
+	}, nil)
+	r := &DomainContactsResource{client: newTestClient(server.URL)}
+
+	imported := importResource(t, r, "example.com")
+	if imported.Diagnostics.HasError() {
+		t.Fatalf("ImportState() diagnostics = %v", imported.Diagnostics)
+	}
+
+	read := readResource(t, r, imported.State)
+	if read.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics = %v", read.Diagnostics)
+	}
+	if !read.State.Raw.Equal(imported.State.Raw) {
+		t.Errorf("Read() changed the imported state:\n%s\nwant:\n%s", read.State.Raw, imported.State.Raw)
+	}
+
+	// A configuration written from the imported contacts plans no changes.
+	var data DomainContactsResourceModel
+	read.Diagnostics.Append(read.State.Get(ctx, &data)...)
+	config := map[string]tftypes.Value{"domain": tftypes.NewValue(tftypes.String, "example.com")}
+	for _, role := range contactRoles {
+		config[role] = contactValue(t, *objectToContact(*data.role(role)))
+	}
+
+	plan := planResource(t, r, read.State, config)
+	if plan.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() diagnostics = %v", plan.Diagnostics)
+	}
+	if !plan.Plan.Raw.Equal(read.State.Raw) {
+		t.Errorf("plan differs from the imported state:\n%s\nwant:\n%s", plan.Plan.Raw, read.State.Raw)
+	}
+	if data.Registrant.Equal(types.ObjectNull(contactAttributeTypes())) {
+		t.Error("registrant was not imported")
+	}
+}
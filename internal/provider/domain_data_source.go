@@ -20,21 +20,41 @@ func NewDomainDataSource() datasource.DataSource {
 
 type DomainDataSource struct {
 	client *godaddy.Client
+	expiry *domainExpiryChecker
 }
 
 type DomainDataSourceModel struct {
-	Domain              types.String `tfsdk:"domain"`
-	Status              types.String `tfsdk:"status"`
-	Expires             types.String `tfsdk:"expires"`
-	ExpirationProtected types.Bool   `tfsdk:"expiration_protected"`
-	HoldRegistrar       types.Bool   `tfsdk:"hold_registrar"`
-	Locked              types.Bool   `tfsdk:"locked"`
-	Privacy             types.Bool   `tfsdk:"privacy"`
-	RenewAuto           types.Bool   `tfsdk:"renew_auto"`
-	TransferProtected   types.Bool   `tfsdk:"transfer_protected"`
-	Nameservers         types.List   `tfsdk:"nameservers"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	ModifiedAt          types.String `tfsdk:"modified_at"`
+	Domain                 types.String `tfsdk:"domain"`
+	Status                 types.String `tfsdk:"status"`
+	Expires                types.String `tfsdk:"expires"`
+	ExpirationProtected    types.Bool   `tfsdk:"expiration_protected"`
+	HoldRegistrar          types.Bool   `tfsdk:"hold_registrar"`
+	Locked                 types.Bool   `tfsdk:"locked"`
+	Privacy                types.Bool   `tfsdk:"privacy"`
+	RenewAuto              types.Bool   `tfsdk:"renew_auto"`
+	TransferProtected      types.Bool   `tfsdk:"transfer_protected"`
+	Nameservers            types.List   `tfsdk:"nameservers"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ModifiedAt             types.String `tfsdk:"modified_at"`
+	DomainID               types.Int64  `tfsdk:"domain_id"`
+	RenewDeadline          types.String `tfsdk:"renew_deadline"`
+	TransferAwayEligibleAt types.String `tfsdk:"transfer_away_eligible_at"`
+	DeletedAt              types.String `tfsdk:"deleted_at"`
+	RegistrarCreatedAt     types.String `tfsdk:"registrar_created_at"`
+	AuthCodeAvailable      types.Bool   `tfsdk:"auth_code_available"`
+	ContactAdmin           types.Object `tfsdk:"contact_admin"`
+	ContactBilling         types.Object `tfsdk:"contact_billing"`
+	ContactRegistrant      types.Object `tfsdk:"contact_registrant"`
+	ContactTech            types.Object `tfsdk:"contact_tech"`
+	DNSSECEnabled          types.Bool   `tfsdk:"dnssec_enabled"`
+	DNSSECKeys             types.List   `tfsdk:"dnssec_keys"`
+}
+
+type DomainDNSSECKeyModel struct {
+	Algorithm types.Int64  `tfsdk:"algorithm"`
+	Flags     types.Int64  `tfsdk:"flags"`
+	Protocol  types.Int64  `tfsdk:"protocol"`
+	PublicKey types.String `tfsdk:"public_key"`
 }
 
 func (d *DomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -94,25 +114,125 @@ func (d *DomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "When the domain was last modified.",
 				Computed:            true,
 			},
+			"domain_id": schema.Int64Attribute{
+				MarkdownDescription: "GoDaddy's ID of the domain.",
+				Computed:            true,
+			},
+			"renew_deadline": schema.StringAttribute{
+				MarkdownDescription: "The last date the domain can be renewed before it is lost.",
+				Computed:            true,
+			},
+			"transfer_away_eligible_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain becomes eligible to transfer to another registrar.",
+				Computed:            true,
+			},
+			"deleted_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain was deleted, if it has been.",
+				Computed:            true,
+			},
+			"registrar_created_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain was created at the current registrar.",
+				Computed:            true,
+			},
+			"auth_code_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether GoDaddy has a transfer auth code for the domain. The code itself is not exposed; " +
+					"use the `godaddy_domain_auth_code` ephemeral resource to read it.",
+				Computed: true,
+			},
+			"contact_admin": schema.SingleNestedAttribute{
+				MarkdownDescription: "Administrative contact.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes:          dataSourceContactAttributes(),
+			},
+			"contact_billing": schema.SingleNestedAttribute{
+				MarkdownDescription: "Billing contact.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes:          dataSourceContactAttributes(),
+			},
+			"contact_registrant": schema.SingleNestedAttribute{
+				MarkdownDescription: "Registrant contact.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes:          dataSourceContactAttributes(),
+			},
+			"contact_tech": schema.SingleNestedAttribute{
+				MarkdownDescription: "Technical contact.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes:          dataSourceContactAttributes(),
+			},
+			"dnssec_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether DNSSEC is enabled for the domain.",
+				Computed:            true,
+			},
+			"dnssec_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "DNSSEC keys of the domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "DNSSEC algorithm number.",
+							Computed:            true,
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "Key flags (257 for a KSK, 256 for a ZSK).",
+							Computed:            true,
+						},
+						"protocol": schema.Int64Attribute{
+							MarkdownDescription: "Key protocol, always 3.",
+							Computed:            true,
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "Base64 encoded public key.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// dataSourceContactAttributes returns the attributes of a contact, built from
+// the same fields as the contact blocks of the godaddy_domain resource.
+func dataSourceContactAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(contactFields))
+	for _, field := range contactFields {
+		attributes[field.name] = schema.StringAttribute{
+			MarkdownDescription: field.description,
+			Computed:            true,
+		}
+	}
+	return attributes
+}
+
+// dataSourceContact converts a contact for the data source. A role GoDaddy
+// returned no contact for is null.
+func dataSourceContact(contact godaddy.DomainContact) types.Object {
+	if contact == (godaddy.DomainContact{}) {
+		return types.ObjectNull(contactAttributeTypes())
+	}
+	return contactToObjectWithNulls(contact)
+}
+
 func (d *DomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*godaddy.Client)
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *godaddy.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
+	d.expiry = providerData.Expiry
 }
 
 func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -166,5 +286,48 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Nameservers = types.ListNull(types.StringType)
 	}
 
+	data.DomainID = types.Int64Value(int64(domain.DomainID))
+	data.RenewDeadline = optionalTime(domain.RenewDeadline)
+	data.TransferAwayEligibleAt = optionalTime(domain.TransferAwayEligibleAt)
+	data.DeletedAt = optionalTime(domain.DeletedAt)
+	data.RegistrarCreatedAt = optionalTime(domain.RegistrarCreatedAt)
+	data.AuthCodeAvailable = types.BoolValue(domain.AuthCode != "")
+
+	data.ContactAdmin = dataSourceContact(domain.ContactAdmin)
+	data.ContactBilling = dataSourceContact(domain.ContactBilling)
+	data.ContactRegistrant = dataSourceContact(domain.ContactRegistrant)
+	data.ContactTech = dataSourceContact(domain.ContactTech)
+
+	// Convert DNSSEC keys
+	keys := []DomainDNSSECKeyModel{}
+	data.DNSSECEnabled = types.BoolValue(false)
+	if domain.DNSSec != nil {
+		data.DNSSECEnabled = types.BoolValue(domain.DNSSec.Enabled)
+		for _, key := range domain.DNSSec.Keys {
+			keys = append(keys, DomainDNSSECKeyModel{
+				Algorithm: types.Int64Value(int64(key.Algorithm)),
+				Flags:     types.Int64Value(int64(key.Flags)),
+				Protocol:  types.Int64Value(int64(key.Protocol)),
+				PublicKey: types.StringValue(key.PublicKey),
+			})
+		}
+	}
+	dnssecKeys, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: map[string]attr.Type{
+		"algorithm":  types.Int64Type,
+		"flags":      types.Int64Type,
+		"protocol":   types.Int64Type,
+		"public_key": types.StringType,
+	}}, keys)
+	resp.Diagnostics.Append(diags...)
+	data.DNSSECKeys = dnssecKeys
+
+	// A data source is only read while it is in the configuration, so a
+	// lapsed domain fails the read, as it fails the plan of godaddy_domain
+	resp.Diagnostics.Append(d.expiry.checkDeadline(domain.Domain)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(d.expiry.check(domain.Domain)...)
 }
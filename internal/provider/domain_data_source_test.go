@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{
+			"domain":"example.com","domainId":1234,"status":"ACTIVE",
+			"createdAt":"2020-01-01T00:00:00Z","expires":"2027-01-01T00:00:00Z",
+			"renewDeadline":"2027-02-15T00:00:00Z","transferAwayEligibleAt":"2020-03-01T00:00:00Z",
+			"authCode":"secret-code",
+			"contactRegistrant":{"nameFirst":"Jane","nameLast":"Doe","email":"jane@example.com","phone":"+1.5555551234",
+				"addressMailing":{"address1":"1 Main St","city":"Springfield","state":"IL","postalCode":"62701","country":"US"}},
+			"dnssec":{"enabled":true,"keys":[{"algorithm":13,"flags":257,"protocol":3,"publicKey":"abc="}]}
+		}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	resp := readDataSource(t, &DomainDataSource{client: newTestClient(server.URL)}, map[string]tftypes.Value{
+		"domain": tftypes.NewValue(tftypes.String, "example.com"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data DomainDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if data.DomainID.ValueInt64() != 1234 {
+		t.Errorf("domain_id = %s, want 1234", data.DomainID)
+	}
+	if data.RenewDeadline.ValueString() != "2027-02-15T00:00:00Z" {
+		t.Errorf("renew_deadline = %s", data.RenewDeadline)
+	}
+	if !data.DeletedAt.IsNull() {
+		t.Errorf("deleted_at = %s, want null", data.DeletedAt)
+	}
+	if !data.AuthCodeAvailable.ValueBool() {
+		t.Error("auth_code_available = false, want true")
+	}
+	if contact := objectToContact(data.ContactRegistrant); contact == nil || contact.Email != "jane@example.com" {
+		t.Errorf("contact_registrant = %v", data.ContactRegistrant)
+	}
+	if organization := data.ContactRegistrant.Attributes()["organization"]; !organization.IsNull() {
+		t.Errorf("contact_registrant.organization = %s, want null when GoDaddy returns none", organization)
+	}
+	if !data.ContactAdmin.IsNull() || !data.ContactTech.IsNull() {
+		t.Errorf("contact_admin = %s, contact_tech = %s, want null for roles GoDaddy returns empty", data.ContactAdmin, data.ContactTech)
+	}
+	if !data.DNSSECEnabled.ValueBool() || len(data.DNSSECKeys.Elements()) != 1 {
+		t.Errorf("dnssec_enabled = %s, dnssec_keys = %s", data.DNSSECEnabled, data.DNSSECKeys)
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainDNSSECResource{}
+var _ resource.ResourceWithImportState = &DomainDNSSECResource{}
+var _ resource.ResourceWithValidateConfig = &DomainDNSSECResource{}
+
+func NewDomainDNSSECResource() resource.Resource {
+	return &DomainDNSSECResource{}
+}
+
+type DomainDNSSECResource struct {
+	client  *godaddy.Client
+	actions *domainActionWaiter
+}
+
+type DomainDNSSECResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Domain  types.String `tfsdk:"domain"`
+	Records types.Set    `tfsdk:"records"`
+}
+
+type DSRecordModel struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+}
+
+func (r *DomainDNSSECResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_dnssec"
+}
+
+func (r *DomainDNSSECResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DS records published at the registry for a GoDaddy domain. " +
+			"Use this when the zone is signed by an external DNS provider. Requires the provider `customer_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to publish DS records for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of DS records for the domain. Records found at the registry " +
+					"that are not listed here are removed.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.Int64Attribute{
+							MarkdownDescription: "Key tag of the DNSKEY the record refers to (0-65535).",
+							Required:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "DNSSEC algorithm number (e.g. 8 for RSASHA256, 13 for ECDSAP256SHA256).",
+							Required:            true,
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "Digest type number (1 SHA-1, 2 SHA-256, 3 GOST R 34.11-94, 4 SHA-384).",
+							Required:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "Hex-encoded digest. Its length must match the digest type.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainDNSSECResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainDNSSECResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Records.IsNull() || data.Records.IsUnknown() {
+		return
+	}
+
+	var records []DSRecordModel
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(records) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("records"),
+			"Invalid DS Records",
+			"At least one DS record is required. Remove the resource to unpublish all DS records.",
+		)
+		return
+	}
+
+	for _, record := range records {
+		if record.KeyTag.IsUnknown() || record.Algorithm.IsUnknown() || record.DigestType.IsUnknown() || record.Digest.IsUnknown() {
+			continue
+		}
+
+		err := godaddy.ValidateDSRecord(
+			int(record.KeyTag.ValueInt64()),
+			int(record.Algorithm.ValueInt64()),
+			int(record.DigestType.ValueInt64()),
+			record.Digest.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"Invalid DS Record",
+				fmt.Sprintf("DS record with key tag %d is invalid: %s", record.KeyTag.ValueInt64(), err),
+			)
+		}
+	}
+}
+
+func (r *DomainDNSSECResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.actions = providerData.Actions
+}
+
+func (r *DomainDNSSECResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainDNSSECResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []DSRecordModel
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRecords(ctx, data.Domain.ValueString(), desired); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Publishing DS Records",
+			fmt.Sprintf("Could not publish DS records for domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString())
+
+	tflog.Trace(ctx, "created domain DNSSEC resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainDNSSECResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainDNSSECResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetDNSSECRecords(ctx, data.Domain.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading DS Records",
+			fmt.Sprintf("Could not read DS records for domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	var prior []DSRecordModel
+	if !data.Records.IsNull() && !data.Records.IsUnknown() {
+		resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	records := make([]DSRecordModel, 0, len(current))
+	for _, record := range current {
+		model, err := dsRecordToModel(record)
+		if err != nil {
+			tflog.Warn(ctx, "Ignoring DS record with unsupported values", map[string]interface{}{
+				"domain": data.Domain.ValueString(),
+				"keyTag": record.KeyTag,
+				"error":  err.Error(),
+			})
+			continue
+		}
+
+		// Keep the configured digest casing so a case-only difference is not
+		// reported as drift.
+		for _, p := range prior {
+			if dsRecordKey(p) == dsRecordKey(model) {
+				model.Digest = p.Digest
+				break
+			}
+		}
+		records = append(records, model)
+	}
+
+	recordSet, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dsRecordAttributeTypes()}, records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString())
+	data.Records = recordSet
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainDNSSECResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainDNSSECResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []DSRecordModel
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRecords(ctx, data.Domain.ValueString(), desired); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DS Records",
+			fmt.Sprintf("Could not update DS records for domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainDNSSECResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainDNSSECResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRecords(ctx, data.Domain.ValueString(), nil); err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing DS Records",
+			fmt.Sprintf("Could not remove DS records for domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *DomainDNSSECResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// syncRecords makes the registry's DS records match desired, removing any
+// record that is not desired and adding the ones that are missing.
+func (r *DomainDNSSECResource) syncRecords(ctx context.Context, domain string, desired []DSRecordModel) error {
+	current, err := r.client.GetDNSSECRecords(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, model := range desired {
+		wanted[dsRecordKey(model)] = true
+	}
+
+	present := make(map[string]bool, len(current))
+	var toRemove []godaddy.DNSSECRecord
+	for _, record := range current {
+		model, err := dsRecordToModel(record)
+		if err != nil {
+			// Records the provider can't represent are never desired.
+			toRemove = append(toRemove, record)
+			continue
+		}
+		key := dsRecordKey(model)
+		present[key] = true
+		if !wanted[key] {
+			toRemove = append(toRemove, record)
+		}
+	}
+
+	var toAdd []godaddy.DNSSECRecord
+	for _, model := range desired {
+		if present[dsRecordKey(model)] {
+			continue
+		}
+		record, err := modelToDSRecord(model)
+		if err != nil {
+			return err
+		}
+		toAdd = append(toAdd, record)
+	}
+
+	tflog.Debug(ctx, "Synchronizing DS records", map[string]interface{}{
+		"domain":   domain,
+		"adding":   len(toAdd),
+		"removing": len(toRemove),
+	})
+
+	// Add before removing so a key rollover never leaves the domain without
+	// a DS record.
+	if len(toAdd) > 0 {
+		since := time.Now()
+		if err := r.client.AddDNSSECRecords(ctx, domain, toAdd); err != nil {
+			return err
+		}
+		if diags := r.actions.wait(ctx, domain, since, godaddy.ActionDNSSECCreate); diags.HasError() {
+			return diagnosticsError(diags)
+		}
+	}
+
+	if len(toRemove) > 0 {
+		since := time.Now()
+		if err := r.client.RemoveDNSSECRecords(ctx, domain, toRemove); err != nil {
+			return err
+		}
+		if diags := r.actions.wait(ctx, domain, since, godaddy.ActionDNSSECDelete); diags.HasError() {
+			return diagnosticsError(diags)
+		}
+	}
+
+	return nil
+}
+
+func dsRecordKey(model DSRecordModel) string {
+	return fmt.Sprintf("%d/%d/%d/%s",
+		model.KeyTag.ValueInt64(),
+		model.Algorithm.ValueInt64(),
+		model.DigestType.ValueInt64(),
+		strings.ToUpper(model.Digest.ValueString()))
+}
+
+func dsRecordToModel(record godaddy.DNSSECRecord) (DSRecordModel, error) {
+	algorithm, ok := godaddy.DNSSECAlgorithmNumber(record.Algorithm)
+	if !ok {
+		return DSRecordModel{}, fmt.Errorf("unsupported DNSSEC algorithm %q", record.Algorithm)
+	}
+
+	digestType, ok := godaddy.DNSSECDigestTypeNumber(record.DigestType)
+	if !ok {
+		return DSRecordModel{}, fmt.Errorf("unsupported DS digest type %q", record.DigestType)
+	}
+
+	return DSRecordModel{
+		KeyTag:     types.Int64Value(int64(record.KeyTag)),
+		Algorithm:  types.Int64Value(int64(algorithm)),
+		DigestType: types.Int64Value(int64(digestType)),
+		Digest:     types.StringValue(record.Digest),
+	}, nil
+}
+
+func modelToDSRecord(model DSRecordModel) (godaddy.DNSSECRecord, error) {
+	algorithm, ok := godaddy.DNSSECAlgorithmName(int(model.Algorithm.ValueInt64()))
+	if !ok {
+		return godaddy.DNSSECRecord{}, fmt.Errorf("unsupported DNSSEC algorithm %d", model.Algorithm.ValueInt64())
+	}
+
+	digestType, ok := godaddy.DNSSECDigestTypeName(int(model.DigestType.ValueInt64()))
+	if !ok {
+		return godaddy.DNSSECRecord{}, fmt.Errorf("unsupported DS digest type %d", model.DigestType.ValueInt64())
+	}
+
+	return godaddy.DNSSECRecord{
+		KeyTag:     int(model.KeyTag.ValueInt64()),
+		Algorithm:  algorithm,
+		DigestType: digestType,
+		Digest:     model.Digest.ValueString(),
+	}, nil
+}
+
+func dsRecordAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key_tag":     types.Int64Type,
+		"algorithm":   types.Int64Type,
+		"digest_type": types.Int64Type,
+		"digest":      types.StringType,
+	}
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	oldDSDigest = "1F987CC6583E92DF0890718C42F3C42F9DBD7C0E0D6C1F4DB8B4D2C1A3E2F7A1"
+	newDSDigest = "E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+)
+
+var (
+	oldDSRecord = godaddy.DNSSECRecord{KeyTag: 2371, Algorithm: "ECDSAP256SHA256", DigestType: "SHA256", Digest: oldDSDigest}
+	newDSRecord = godaddy.DNSSECRecord{KeyTag: 31589, Algorithm: "ECDSAP256SHA256", DigestType: "SHA256", Digest: newDSDigest}
+)
+
+var dsRecordType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"key_tag":     tftypes.Number,
+	"algorithm":   tftypes.Number,
+	"digest_type": tftypes.Number,
+	"digest":      tftypes.String,
+}}
+
+func dsRecordValue(keyTag, algorithm, digestType int, digest string) tftypes.Value {
+	return tftypes.NewValue(dsRecordType, map[string]tftypes.Value{
+		"key_tag":     tftypes.NewValue(tftypes.Number, keyTag),
+		"algorithm":   tftypes.NewValue(tftypes.Number, algorithm),
+		"digest_type": tftypes.NewValue(tftypes.Number, digestType),
+		"digest":      tftypes.NewValue(tftypes.String, digest),
+	})
+}
+
+func dnssecValues(records ...tftypes.Value) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "example.com"),
+		"domain":  tftypes.NewValue(tftypes.String, "example.com"),
+		"records": tftypes.NewValue(tftypes.Set{ElementType: dsRecordType}, records),
+	}
+}
+
+// fakeDNSSECServer serves the DS records of example.com and records the
+// changes made to them, in order, as "add <key tag>" and "remove <key tag>".
+// A nil records slice means the domain is not in the account.
+func fakeDNSSECServer(t *testing.T, records []godaddy.DNSSECRecord, changes *[]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com":
+			if records == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"domain": "example.com", "dnssecRecords": records})
+		case (r.Method == http.MethodPatch || r.Method == http.MethodDelete) &&
+			r.URL.Path == "/v2/customers/cust-1/domains/example.com/dnssecRecords":
+			change := "add"
+			if r.Method == http.MethodDelete {
+				change = "remove"
+			}
+			var sent []godaddy.DNSSECRecord
+			json.NewDecoder(r.Body).Decode(&sent)
+			for _, record := range sent {
+				*changes = append(*changes, fmt.Sprintf("%s %d", change, record.KeyTag))
+			}
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDomainDNSSECResource_SyncRecords(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []godaddy.DNSSECRecord
+		desired     []godaddy.DNSSECRecord
+		wantChanges []string
+	}{
+		{name: "rollover adds before removing", current: []godaddy.DNSSECRecord{oldDSRecord},
+			desired: []godaddy.DNSSECRecord{newDSRecord}, wantChanges: []string{"add 31589", "remove 2371"}},
+		{name: "unchanged", current: []godaddy.DNSSECRecord{oldDSRecord},
+			desired: []godaddy.DNSSECRecord{oldDSRecord}},
+		{name: "digest case only", current: []godaddy.DNSSECRecord{{KeyTag: 2371, Algorithm: "ECDSAP256SHA256",
+			DigestType: "SHA256", Digest: "1f987cc6583e92df0890718c42f3c42f9dbd7c0e0d6c1f4db8b4d2c1a3e2f7a1"}},
+			desired: []godaddy.DNSSECRecord{oldDSRecord}},
+		{name: "unsupported record removed", current: []godaddy.DNSSECRecord{oldDSRecord,
+			{KeyTag: 100, Algorithm: "UNKNOWN", DigestType: "SHA256", Digest: oldDSDigest}},
+			desired: []godaddy.DNSSECRecord{oldDSRecord}, wantChanges: []string{"remove 100"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes []string
+			r := &DomainDNSSECResource{client: newTestClient(fakeDNSSECServer(t, tt.current, &changes).URL)}
+
+			desired := make([]DSRecordModel, len(tt.desired))
+			for i, record := range tt.desired {
+				model, err := dsRecordToModel(record)
+				if err != nil {
+					t.Fatalf("dsRecordToModel() error = %v", err)
+				}
+				desired[i] = model
+			}
+
+			if err := r.syncRecords(context.Background(), "example.com", desired); err != nil {
+				t.Fatalf("syncRecords() error = %v", err)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %v, want %v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestDomainDNSSECResource_Create(t *testing.T) {
+	var changes []string
+	r := &DomainDNSSECResource{client: newTestClient(fakeDNSSECServer(t, []godaddy.DNSSECRecord{oldDSRecord}, &changes).URL)}
+
+	config := dnssecValues(dsRecordValue(31589, 13, 2, newDSDigest))
+	delete(config, "id")
+
+	resp := createResource(t, r, config)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+	}
+	if want := []string{"add 31589", "remove 2371"}; !reflect.DeepEqual(changes, want) {
+		t.Errorf("changes = %v, want %v", changes, want)
+	}
+
+	var data DomainDNSSECResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID.ValueString() != "example.com" {
+		t.Errorf("id = %s, want example.com", data.ID)
+	}
+}
+
+func TestDomainDNSSECResource_Read(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []godaddy.DNSSECRecord
+		wantRemoved bool
+		wantRecords []DSRecordModel
+	}{
+		{name: "keeps configured digest casing", current: []godaddy.DNSSECRecord{{KeyTag: 2371,
+			Algorithm: "ECDSAP256SHA256", DigestType: "SHA256", Digest: "1f987cc6583e92df0890718c42f3c42f9dbd7c0e0d6c1f4db8b4d2c1a3e2f7a1"}},
+			wantRecords: []DSRecordModel{mustDSRecordModel(t, oldDSRecord)}},
+		{name: "reports added records", current: []godaddy.DNSSECRecord{oldDSRecord, newDSRecord},
+			wantRecords: []DSRecordModel{mustDSRecordModel(t, oldDSRecord), mustDSRecordModel(t, newDSRecord)}},
+		{name: "ignores unsupported records", current: []godaddy.DNSSECRecord{oldDSRecord,
+			{KeyTag: 100, Algorithm: "UNKNOWN", DigestType: "SHA256", Digest: oldDSDigest}},
+			wantRecords: []DSRecordModel{mustDSRecordModel(t, oldDSRecord)}},
+		{name: "domain gone", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes []string
+			r := &DomainDNSSECResource{client: newTestClient(fakeDNSSECServer(t, tt.current, &changes).URL)}
+
+			state := newResourceState(t, r, dnssecValues(dsRecordValue(2371, 13, 2, oldDSDigest)))
+			resp := readResource(t, r, state)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+			if tt.wantRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Error("Read() kept the DS records of a domain that is gone")
+				}
+				return
+			}
+
+			var data DomainDNSSECResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			var records []DSRecordModel
+			resp.Diagnostics.Append(data.Records.ElementsAs(context.Background(), &records, false)...)
+			if len(records) != len(tt.wantRecords) {
+				t.Fatalf("records = %v, want %v", records, tt.wantRecords)
+			}
+			for i, want := range tt.wantRecords {
+				if records[i] != want {
+					t.Errorf("records[%d] = %v, want %v", i, records[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDomainDNSSECResource_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []godaddy.DNSSECRecord
+		wantChanges []string
+	}{
+		{name: "removes all records", current: []godaddy.DNSSECRecord{oldDSRecord, newDSRecord},
+			wantChanges: []string{"remove 2371", "remove 31589"}},
+		{name: "domain gone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes []string
+			r := &DomainDNSSECResource{client: newTestClient(fakeDNSSECServer(t, tt.current, &changes).URL)}
+
+			resp := deleteResource(t, r, newResourceState(t, r, dnssecValues(dsRecordValue(2371, 13, 2, oldDSDigest))))
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete() diagnostics = %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %v, want %v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestDomainDNSSECResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		records   []tftypes.Value
+		wantError bool
+	}{
+		{name: "valid", records: []tftypes.Value{dsRecordValue(2371, 13, 2, oldDSDigest)}},
+		{name: "no records", records: []tftypes.Value{}, wantError: true},
+		{name: "digest length", records: []tftypes.Value{dsRecordValue(2371, 13, 2, "1F98")}, wantError: true},
+		{name: "unsupported algorithm", records: []tftypes.Value{dsRecordValue(2371, 2, 2, oldDSDigest)}, wantError: true},
+		{name: "key tag out of range", records: []tftypes.Value{dsRecordValue(70000, 13, 2, oldDSDigest)}, wantError: true},
+		{name: "unknown digest", records: []tftypes.Value{tftypes.NewValue(dsRecordType, map[string]tftypes.Value{
+			"key_tag":     tftypes.NewValue(tftypes.Number, 2371),
+			"algorithm":   tftypes.NewValue(tftypes.Number, 13),
+			"digest_type": tftypes.NewValue(tftypes.Number, 2),
+			"digest":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainDNSSECResource{}
+			s := resourceSchema(r)
+
+			values := dnssecValues(tt.records...)
+			delete(values, "id")
+			config := tfsdk.Config{Schema: s, Raw: newTestObject(t, s.Type(), values)}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateConfig() diagnostics = %v, want error %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
+
+func mustDSRecordModel(t *testing.T, record godaddy.DNSSECRecord) DSRecordModel {
+	t.Helper()
+	model, err := dsRecordToModel(record)
+	if err != nil {
+		t.Fatalf("dsRecordToModel() error = %v", err)
+	}
+	return model
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultExpiryWarningDays is used when expiry_warning_days is not set
+const defaultExpiryWarningDays = 30
+
+// domainExpiryChecker reports domains that are about to lapse when they are
+// read, according to the provider's expiry_warning_days setting. A nil
+// checker reports nothing.
+type domainExpiryChecker struct {
+	warningDays int
+	now         func() time.Time
+}
+
+func newDomainExpiryChecker(warningDays int) *domainExpiryChecker {
+	if warningDays <= 0 {
+		return nil
+	}
+	return &domainExpiryChecker{warningDays: warningDays, now: time.Now}
+}
+
+// pastDeadlineRemediation tells users how to get a domain that can no longer
+// be renewed normally out of the way.
+const pastDeadlineRemediation = "Redeem it with the godaddy_domain_redeem action, or remove it from the configuration " +
+	"(for a godaddy_domain resource, with `terraform state rm` or a `removed` block). " +
+	"Set expiry_warning_days = 0 on the provider to turn this check off."
+
+// pastDeadline reports whether the renewal deadline of a domain without
+// auto-renew has passed, so that it can no longer be renewed normally.
+func (c *domainExpiryChecker) pastDeadline(domain godaddy.Domain) bool {
+	return !domain.RenewAuto && domain.RenewDeadline != nil && c.now().After(*domain.RenewDeadline)
+}
+
+// check warns about a domain expiring within the warning window or past its
+// renewal deadline. It only warns, so that a lapsed godaddy_domain can still
+// be refreshed while it is destroyed or removed from the configuration.
+func (c *domainExpiryChecker) check(domain godaddy.Domain) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil {
+		return diags
+	}
+	now := c.now()
+
+	if c.pastDeadline(domain) {
+		diags.AddWarning(
+			"Domain Past Renewal Deadline",
+			fmt.Sprintf("The renewal deadline of %s passed on %s and auto-renew is off. "+
+				"Plans that keep managing it with godaddy_domain fail until it is dealt with. %s",
+				domain.Domain, domain.RenewDeadline.UTC().Format(time.RFC3339), pastDeadlineRemediation),
+		)
+		return diags
+	}
+
+	if domain.Expires == nil || domain.Expires.After(now.AddDate(0, 0, c.warningDays)) {
+		return diags
+	}
+
+	renewal := "Auto-renew is off, so it must be renewed manually."
+	if domain.RenewAuto {
+		renewal = "Auto-renew is on; make sure the payment method on file is valid."
+	}
+	if domain.Expires.Before(now) {
+		diags.AddWarning(
+			"Domain Expired",
+			fmt.Sprintf("%s expired on %s. %s", domain.Domain, domain.Expires.UTC().Format(time.RFC3339), renewal),
+		)
+		return diags
+	}
+	diags.AddWarning(
+		"Domain Expiring Soon",
+		fmt.Sprintf("%s expires on %s, in %d day(s). %s", domain.Domain, domain.Expires.UTC().Format(time.RFC3339),
+			int(domain.Expires.Sub(now).Hours()/24), renewal),
+	)
+	return diags
+}
+
+// checkDeadline reports a domain past its renewal deadline as an error. It
+// runs when planning a godaddy_domain that is still in the configuration and
+// when reading the godaddy_domain data source.
+func (c *domainExpiryChecker) checkDeadline(domain godaddy.Domain) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || !c.pastDeadline(domain) {
+		return diags
+	}
+
+	diags.AddError(
+		"Domain Past Renewal Deadline",
+		fmt.Sprintf("The renewal deadline of %s passed on %s and auto-renew is off, so the domain can no longer be renewed normally. %s",
+			domain.Domain, domain.RenewDeadline.UTC().Format(time.RFC3339), pastDeadlineRemediation),
+	)
+	return diags
+}
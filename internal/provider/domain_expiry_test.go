@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainExpiryChecker_Check(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	days := func(n int) *time.Time {
+		value := now.AddDate(0, 0, n)
+		return &value
+	}
+
+	tests := []struct {
+		name        string
+		domain      godaddy.Domain
+		wantWarning bool
+		// wantError is for the plan-time deadline check; reads only warn
+		wantError bool
+	}{
+		{
+			name:   "far from expiry",
+			domain: godaddy.Domain{Domain: "example.com", Expires: days(90), RenewDeadline: days(120)},
+		},
+		{
+			name:        "inside the window",
+			domain:      godaddy.Domain{Domain: "example.com", Expires: days(10), RenewDeadline: days(40), RenewAuto: true},
+			wantWarning: true,
+		},
+		{
+			name:        "expired within the grace period",
+			domain:      godaddy.Domain{Domain: "example.com", Expires: days(-5), RenewDeadline: days(25)},
+			wantWarning: true,
+		},
+		{
+			name:        "past the renewal deadline",
+			domain:      godaddy.Domain{Domain: "example.com", Expires: days(-40), RenewDeadline: days(-10)},
+			wantWarning: true,
+			wantError:   true,
+		},
+		{
+			name:        "past the renewal deadline with auto-renew",
+			domain:      godaddy.Domain{Domain: "example.com", Expires: days(-40), RenewDeadline: days(-10), RenewAuto: true},
+			wantWarning: true,
+		},
+		{
+			name:   "unknown expiry",
+			domain: godaddy.Domain{Domain: "example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := newDomainExpiryChecker(30)
+			checker.now = func() time.Time { return now }
+
+			diags := checker.check(tt.domain)
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("expected warning %v, got %v", tt.wantWarning, diags)
+			}
+			if diags.HasError() {
+				t.Errorf("expected no error when reading, got %v", diags)
+			}
+			if got := checker.checkDeadline(tt.domain).HasError(); got != tt.wantError {
+				t.Errorf("expected deadline error %v, got %v", tt.wantError, got)
+			}
+		})
+	}
+
+	disabled := newDomainExpiryChecker(0)
+	lapsed := godaddy.Domain{Domain: "example.com", RenewDeadline: days(-10)}
+	if diags := append(disabled.check(lapsed), disabled.checkDeadline(lapsed)...); len(diags) != 0 {
+		t.Errorf("expected no diagnostics with expiry_warning_days = 0, got %v", diags)
+	}
+}
+
+// TestDomainExpiryChecker_PastDeadlineReads covers where a domain past its
+// renewal deadline is an error. The data source is only read while it is in
+// the configuration, so it fails. The resource only warns when it is refreshed:
+// its plan fails instead (see TestDomainResource_PlanPastRenewalDeadline), and
+// failing the refresh as well would block the destroy or `removed` block that
+// resolves the error.
+func TestDomainExpiryChecker_PastDeadlineReads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/domains/example.com":
+			w.Write([]byte(`{"domain":"example.com","status":"ACTIVE","renewAuto":false,
+				"createdAt":"2020-01-01T00:00:00Z","expires":"2026-08-01T00:00:00Z","renewDeadline":"2026-09-01T00:00:00Z"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com/changeOfRegistrant":
+			// No change of registrant pending
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	expiry := newDomainExpiryChecker(30)
+	expiry.now = func() time.Time { return time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC) }
+	client := newTestClient(server.URL)
+
+	t.Run("data source", func(t *testing.T) {
+		resp := readDataSource(t, &DomainDataSource{client: client, expiry: expiry}, map[string]tftypes.Value{
+			"domain": tftypes.NewValue(tftypes.String, "example.com"),
+		})
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("Read() expected an error for a domain past its renewal deadline")
+		}
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "godaddy_domain_redeem") {
+			t.Errorf("Read() error = %s, want the remediation", detail)
+		}
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		r := &DomainResource{client: client, expiry: expiry}
+		resp := readResource(t, r, newResourceState(t, r, map[string]tftypes.Value{
+			"domain":                   tftypes.NewValue(tftypes.String, "example.com"),
+			"outbound_transfer_policy": tftypes.NewValue(tftypes.String, outboundTransferManual),
+		}))
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read() diagnostics = %v, want only a warning", resp.Diagnostics)
+		}
+		if resp.Diagnostics.WarningsCount() != 1 || resp.State.Raw.IsNull() {
+			t.Errorf("Read() = %v, want the domain kept with a warning", resp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainForwardingResource{}
+var _ resource.ResourceWithImportState = &DomainForwardingResource{}
+var _ resource.ResourceWithValidateConfig = &DomainForwardingResource{}
+
+func NewDomainForwardingResource() resource.Resource {
+	return &DomainForwardingResource{}
+}
+
+type DomainForwardingResource struct {
+	client *godaddy.Client
+}
+
+type DomainForwardingResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	FQDN            types.String `tfsdk:"fqdn"`
+	URL             types.String `tfsdk:"url"`
+	Type            types.String `tfsdk:"type"`
+	MaskTitle       types.String `tfsdk:"mask_title"`
+	MaskDescription types.String `tfsdk:"mask_description"`
+	MaskKeywords    types.String `tfsdk:"mask_keywords"`
+}
+
+func (r *DomainForwardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_forwarding"
+}
+
+func (r *DomainForwardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages forwarding of a GoDaddy domain or subdomain to another URL. Requires the provider `customer_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The forwarded hostname.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The domain or subdomain to forward (e.g. `example.com` or `www.example.com`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The http or https URL to forward to.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Forwarding type: `REDIRECT_PERMANENT` (301), `REDIRECT_TEMPORARY` (302) or `MASKED`. " +
+					"Defaults to `REDIRECT_PERMANENT`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(godaddy.ForwardingRedirectPermanent),
+				Validators: []validator.String{
+					StringOneOfValidator(godaddy.ValidForwardingTypes()...),
+				},
+			},
+			"mask_title": schema.StringAttribute{
+				MarkdownDescription: "Page title shown for `MASKED` forwarding.",
+				Optional:            true,
+			},
+			"mask_description": schema.StringAttribute{
+				MarkdownDescription: "Meta description shown for `MASKED` forwarding.",
+				Optional:            true,
+			},
+			"mask_keywords": schema.StringAttribute{
+				MarkdownDescription: "Meta keywords shown for `MASKED` forwarding.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DomainForwardingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.URL.IsNull() && !data.URL.IsUnknown() {
+		parsed, err := url.Parse(data.URL.ValueString())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Invalid URL",
+				fmt.Sprintf("%q is not an absolute http or https URL.", data.URL.ValueString()),
+			)
+		}
+	}
+
+	if data.Type.IsUnknown() || data.Type.ValueString() == godaddy.ForwardingMasked {
+		return
+	}
+
+	for name, value := range map[string]types.String{
+		"mask_title":       data.MaskTitle,
+		"mask_description": data.MaskDescription,
+		"mask_keywords":    data.MaskKeywords,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Mask Attribute Without Masking",
+				fmt.Sprintf("%s can only be set when type is MASKED.", name),
+			)
+		}
+	}
+}
+
+func (r *DomainForwardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DomainForwardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := data.FQDN.ValueString()
+	if err := r.client.CreateDomainForwarding(ctx, fqdn, forwardingFromModel(data)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Domain Forwarding",
+			fmt.Sprintf("Could not create forwarding for %s: %s", fqdn, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fqdn)
+
+	tflog.Trace(ctx, "created domain forwarding resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainForwardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainForwardingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := data.FQDN.ValueString()
+	forwarding, err := r.client.GetDomainForwarding(ctx, fqdn)
+	if err != nil {
+		if errors.Is(err, godaddy.ErrNotFound) || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Forwarding",
+			fmt.Sprintf("Could not read forwarding for %s: %s", fqdn, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fqdn)
+	data.URL = types.StringValue(forwarding.URL)
+	data.Type = types.StringValue(forwarding.Type)
+	data.MaskTitle = types.StringNull()
+	data.MaskDescription = types.StringNull()
+	data.MaskKeywords = types.StringNull()
+	if forwarding.Mask != nil {
+		data.MaskTitle = optionalString(forwarding.Mask.Title)
+		data.MaskDescription = optionalString(forwarding.Mask.Description)
+		data.MaskKeywords = optionalString(forwarding.Mask.Keywords)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := data.FQDN.ValueString()
+	if err := r.client.UpdateDomainForwarding(ctx, fqdn, forwardingFromModel(data)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Domain Forwarding",
+			fmt.Sprintf("Could not update forwarding for %s: %s", fqdn, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainForwardingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := data.FQDN.ValueString()
+	if err := r.client.DeleteDomainForwarding(ctx, fqdn); err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Domain Forwarding",
+			fmt.Sprintf("Could not delete forwarding for %s: %s", fqdn, err),
+		)
+	}
+}
+
+func (r *DomainForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("fqdn"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func forwardingFromModel(data DomainForwardingResourceModel) godaddy.DomainForwarding {
+	forwarding := godaddy.DomainForwarding{
+		Type: data.Type.ValueString(),
+		URL:  data.URL.ValueString(),
+	}
+
+	if forwarding.Type == godaddy.ForwardingMasked {
+		forwarding.Mask = &godaddy.ForwardingMask{
+			Title:       data.MaskTitle.ValueString(),
+			Description: data.MaskDescription.ValueString(),
+			Keywords:    data.MaskKeywords.ValueString(),
+		}
+	}
+
+	return forwarding
+}
+
+// optionalString maps an empty API value to null, so that unset optional
+// attributes don't show a diff.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
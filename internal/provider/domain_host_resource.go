@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainHostResource{}
+var _ resource.ResourceWithImportState = &DomainHostResource{}
+var _ resource.ResourceWithValidateConfig = &DomainHostResource{}
+
+func NewDomainHostResource() resource.Resource {
+	return &DomainHostResource{}
+}
+
+type DomainHostResource struct {
+	client  *godaddy.Client
+	actions *domainActionWaiter
+}
+
+type DomainHostResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Domain   types.String `tfsdk:"domain"`
+	Hostname types.String `tfsdk:"hostname"`
+	IPv4     types.Set    `tfsdk:"ipv4"`
+	IPv6     types.Set    `tfsdk:"ipv6"`
+}
+
+func (r *DomainHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_host"
+}
+
+func (r *DomainHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a host object with glue records at the registry, for nameservers below a GoDaddy domain " +
+			"(e.g. `ns1.example.com`). Requires the provider `customer_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain and hostname, separated by a slash.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain the host belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified hostname of the nameserver. It must be below `domain`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv4": schema.SetAttribute{
+				MarkdownDescription: "IPv4 glue addresses of the host.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ipv6": schema.SetAttribute{
+				MarkdownDescription: "IPv6 glue addresses of the host.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DomainHostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainHostResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Domain.IsUnknown() && !data.Hostname.IsUnknown() {
+		if err := godaddy.ValidateHostInDomain(data.Hostname.ValueString(), data.Domain.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hostname"),
+				"Invalid Hostname",
+				err.Error(),
+			)
+		}
+	}
+
+	if data.IPv4.IsUnknown() || data.IPv6.IsUnknown() {
+		return
+	}
+
+	var ipv4, ipv6 []string
+	resp.Diagnostics.Append(setToStrings(ctx, data.IPv4, &ipv4)...)
+	resp.Diagnostics.Append(setToStrings(ctx, data.IPv6, &ipv6)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(ipv4) == 0 && len(ipv6) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ipv4"),
+			"Missing Glue Addresses",
+			"At least one ipv4 or ipv6 address is required.",
+		)
+	}
+
+	for _, address := range ipv4 {
+		if !godaddy.IsIPv4(address) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ipv4"),
+				"Invalid IPv4 Address",
+				fmt.Sprintf("%q is not a valid IPv4 address.", address),
+			)
+		}
+	}
+
+	for _, address := range ipv6 {
+		if !godaddy.IsIPv6(address) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ipv6"),
+				"Invalid IPv6 Address",
+				fmt.Sprintf("%q is not a valid IPv6 address.", address),
+			)
+		}
+	}
+}
+
+func (r *DomainHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.actions = providerData.Actions
+}
+
+func (r *DomainHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, diags := hostFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := time.Now()
+	if err := r.client.PutDomainHost(ctx, data.Domain.ValueString(), host.Hostname, host); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Host",
+			fmt.Sprintf("Could not create host %s: %s", data.Hostname.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.actions.wait(ctx, data.Domain.ValueString(), since, godaddy.ActionHostCreate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString() + "/" + data.Hostname.ValueString())
+
+	tflog.Trace(ctx, "created domain host resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := strings.ToLower(strings.TrimSuffix(data.Hostname.ValueString(), "."))
+	host, err := r.client.GetDomainHost(ctx, data.Domain.ValueString(), hostname)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Host",
+			fmt.Sprintf("Could not read host %s: %s", data.Hostname.ValueString(), err),
+		)
+		return
+	}
+
+	ipv4, diags := mergeAddresses(ctx, data.IPv4, host.IPv4)
+	resp.Diagnostics.Append(diags...)
+	ipv6, diags := mergeAddresses(ctx, data.IPv6, host.IPv6)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Domain.ValueString() + "/" + data.Hostname.ValueString())
+	data.IPv4 = ipv4
+	data.IPv6 = ipv6
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, diags := hostFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := time.Now()
+	if err := r.client.PutDomainHost(ctx, data.Domain.ValueString(), host.Hostname, host); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Host",
+			fmt.Sprintf("Could not update host %s: %s", data.Hostname.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.actions.wait(ctx, data.Domain.ValueString(), since, godaddy.ActionHostUpdate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := strings.ToLower(strings.TrimSuffix(data.Hostname.ValueString(), "."))
+	since := time.Now()
+	if err := r.client.DeleteDomainHost(ctx, data.Domain.ValueString(), hostname); err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Host",
+			fmt.Sprintf("Could not delete host %s: %s", data.Hostname.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.actions.wait(ctx, data.Domain.ValueString(), since, godaddy.ActionHostDelete)...)
+}
+
+func (r *DomainHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: domain/hostname
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'domain/hostname', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), parts[1])...)
+}
+
+func hostFromModel(ctx context.Context, data DomainHostResourceModel) (godaddy.DomainHost, diag.Diagnostics) {
+	host := godaddy.DomainHost{
+		Hostname: strings.ToLower(strings.TrimSuffix(data.Hostname.ValueString(), ".")),
+		IPv4:     []string{},
+		IPv6:     []string{},
+	}
+
+	var diags diag.Diagnostics
+	diags.Append(setToStrings(ctx, data.IPv4, &host.IPv4)...)
+	diags.Append(setToStrings(ctx, data.IPv6, &host.IPv6)...)
+	return host, diags
+}
+
+// mergeAddresses builds the address set read from the registry, keeping the
+// prior spelling of addresses that are equal once canonicalized so that
+// e.g. 2001:DB8::1 is not reported as drift. An empty result stays null when
+// the prior value was null.
+func mergeAddresses(ctx context.Context, prior types.Set, current []string) (types.Set, diag.Diagnostics) {
+	if len(current) == 0 && prior.IsNull() {
+		return prior, nil
+	}
+
+	var priorAddresses []string
+	diags := setToStrings(ctx, prior, &priorAddresses)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	spelling := make(map[string]string, len(priorAddresses))
+	for _, address := range priorAddresses {
+		spelling[godaddy.CanonicalIP(address)] = address
+	}
+
+	addresses := make([]string, 0, len(current))
+	for _, address := range current {
+		if prior, ok := spelling[godaddy.CanonicalIP(address)]; ok {
+			address = prior
+		}
+		addresses = append(addresses, address)
+	}
+
+	result, d := types.SetValueFrom(ctx, types.StringType, addresses)
+	diags.Append(d...)
+	return result, diags
+}
+
+// setToStrings copies a known string set into target, leaving it untouched
+// for null or unknown sets.
+func setToStrings(ctx context.Context, set types.Set, target *[]string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	return set.ElementsAs(ctx, target, false)
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &DomainRedeemAction{}
+var _ action.ActionWithConfigure = &DomainRedeemAction{}
+var _ action.ActionWithValidateConfig = &DomainRedeemAction{}
+
+const defaultRedeemTimeout = time.Hour
+
+// redeemPollInterval is how often the domain is checked while waiting for
+// the redemption to complete
+const redeemPollInterval = 30 * time.Second
+
+func NewDomainRedeemAction() action.Action {
+	return &DomainRedeemAction{}
+}
+
+type DomainRedeemAction struct {
+	client  *godaddy.Client
+	actions *domainActionWaiter
+	// pollInterval overrides redeemPollInterval when set
+	pollInterval time.Duration
+}
+
+type DomainRedeemActionModel struct {
+	Domain      types.String  `tfsdk:"domain"`
+	AgreedBy    types.String  `tfsdk:"agreed_by"`
+	Fee         types.Float64 `tfsdk:"fee"`
+	WaitTimeout types.String  `tfsdk:"wait_timeout"`
+}
+
+func (a *DomainRedeemAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_redeem"
+}
+
+func (a *DomainRedeemAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a lapsed domain that is still in its redemption grace period. " +
+			"Requires the provider `customer_id` and Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to redeem.",
+				Required:            true,
+			},
+			"agreed_by": schema.StringAttribute{
+				MarkdownDescription: "Who agrees to the redemption charges, usually the IP address of the person invoking the action.",
+				Required:            true,
+			},
+			"fee": schema.Float64Attribute{
+				MarkdownDescription: "The redemption fee being agreed to, as shown by GoDaddy for the domain. " +
+					"The redemption is not made when GoDaddy's fee is different.",
+				Required: true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the domain to become ACTIVE again, as a Go duration. Defaults to `1h`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *DomainRedeemAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data DomainRedeemActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WaitTimeout.IsNull() && !data.WaitTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_timeout"),
+				"Invalid Wait Timeout",
+				fmt.Sprintf("Could not parse %q as a duration: %s", data.WaitTimeout.ValueString(), err),
+			)
+		}
+	}
+
+	if !data.Fee.IsNull() && !data.Fee.IsUnknown() && data.Fee.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fee"),
+			"Invalid Fee",
+			"fee can't be negative.",
+		)
+	}
+}
+
+func (a *DomainRedeemAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = providerData.Client
+	a.actions = providerData.Actions
+}
+
+func (a *DomainRedeemAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DomainRedeemActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	timeout := defaultRedeemTimeout
+	if !data.WaitTimeout.IsNull() {
+		parsed, err := time.ParseDuration(data.WaitTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_timeout"),
+				"Invalid Wait Timeout",
+				err.Error(),
+			)
+			return
+		}
+		timeout = parsed
+	}
+
+	detail, err := a.client.GetDomain(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	if !godaddy.IsInRedemption(detail.Status) {
+		resp.Diagnostics.AddError(
+			"Domain Not In Redemption",
+			fmt.Sprintf("Domain %s has status %s and can't be redeemed. Only domains in their redemption grace period can be restored.", domain, detail.Status),
+		)
+		return
+	}
+
+	// Redeeming a domain renews it for a year on top of the redemption fee
+	quote, err := a.client.GetRedemptionQuote(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Checking Redemption Fee",
+			fmt.Sprintf("Could not get the charges for redeeming %s, so the agreed fee can't be checked: %s", domain, err),
+		)
+		return
+	}
+
+	fee := godaddy.FromMicros(quote.Fee)
+	price := godaddy.FromMicros(quote.Price)
+	if agreed := data.Fee.ValueFloat64(); math.Abs(agreed-fee) >= 0.005 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fee"),
+			"Redemption Fee Mismatch",
+			fmt.Sprintf("GoDaddy charges %.2f %s to redeem %s, but fee is %.2f. Review the charges and set fee to agree to them.",
+				fee, quote.Currency, domain, agreed),
+		)
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Redeeming %s: redemption fee %.2f %s plus one year renewal at %.2f %s",
+		domain, fee, quote.Currency, price, quote.Currency))
+
+	redeem := godaddy.DomainRedeem{
+		Consent: godaddy.RedemptionConsent{
+			AgreedAt: time.Now().UTC().Format(time.RFC3339),
+			AgreedBy: data.AgreedBy.ValueString(),
+			Fee:      quote.Fee,
+			Price:    quote.Price,
+			Currency: quote.Currency,
+		},
+	}
+	since := time.Now()
+	if err := a.client.RedeemDomain(ctx, domain, redeem); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Redeeming Domain",
+			fmt.Sprintf("Could not redeem domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Requested domain redemption", map[string]interface{}{"domain": domain})
+
+	resp.Diagnostics.Append(a.actions.wait(ctx, domain, since, godaddy.ActionRedeem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	interval := redeemPollInterval
+	if a.pollInterval > 0 {
+		interval = a.pollInterval
+	}
+
+	var restored *godaddy.DomainDetail
+	err = godaddy.WaitFor(ctx, godaddy.WaitOptions{Timeout: timeout, Interval: interval}, func(ctx context.Context) (bool, error) {
+		detail, err := a.client.GetDomain(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+		if detail.Status == "ACTIVE" {
+			restored = detail
+			return true, nil
+		}
+
+		sendProgress(resp, fmt.Sprintf("Waiting for %s to become ACTIVE (currently %s)", domain, detail.Status))
+		return false, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Redemption",
+			fmt.Sprintf("Redemption of %s was requested, but the domain did not become ACTIVE: %s", domain, err),
+		)
+		return
+	}
+
+	expires := "unknown"
+	if restored.Expires != nil {
+		expires = restored.Expires.Format(time.RFC3339)
+	}
+	sendProgress(resp, fmt.Sprintf("Redeemed %s; it is ACTIVE again and expires %s", domain, expires))
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func invokeDomainRedeem(t *testing.T, serverURL string, fee float64) (*action.InvokeResponse, []string) {
+	t.Helper()
+
+	a := &DomainRedeemAction{client: newTestClient(serverURL), pollInterval: 10 * time.Millisecond}
+	return invokeAction(t, a, map[string]tftypes.Value{
+		"domain":       tftypes.NewValue(tftypes.String, "example.com"),
+		"agreed_by":    tftypes.NewValue(tftypes.String, "203.0.113.10"),
+		"fee":          tftypes.NewValue(tftypes.Number, fee),
+		"wait_timeout": tftypes.NewValue(tftypes.String, "1m"),
+	})
+}
+
+// fakeRedeemServer serves example.com in redemption with a fee of 80 USD. It
+// becomes ACTIVE a few polls after it is redeemed.
+func fakeRedeemServer(t *testing.T, redeemed *godaddy.RedemptionConsent) *httptest.Server {
+	t.Helper()
+
+	status := "PENDING_REDEMPTION"
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/domains/example.com":
+			if status == "REDEEMING" {
+				polls++
+				if polls > 1 {
+					status = "ACTIVE"
+				}
+			}
+			w.Write([]byte(`{"domain":"example.com","status":"` + status + `","expires":"2027-03-01T00:00:00Z"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com":
+			w.Write([]byte(`{"domain":"example.com","status":"` + status + `",` +
+				`"redemption":{"fee":80000000,"price":11990000,"currency":"USD"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/customers/cust-1/domains/example.com/redeem":
+			var body godaddy.DomainRedeem
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			*redeemed = body.Consent
+			status = "REDEEMING"
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDomainRedeemAction_Invoke(t *testing.T) {
+	var consent godaddy.RedemptionConsent
+	server := fakeRedeemServer(t, &consent)
+
+	resp, messages := invokeDomainRedeem(t, server.URL, 80)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() diagnostics = %v", resp.Diagnostics)
+	}
+	if consent.Fee != 80000000 || consent.Price != 11990000 || consent.Currency != "USD" {
+		t.Errorf("consent = %+v, want GoDaddy's charges", consent)
+	}
+	if len(messages) == 0 || !strings.Contains(messages[0], "80.00 USD") || !strings.Contains(messages[0], "11.99 USD") {
+		t.Errorf("Invoke() progress = %v, want the charges first", messages)
+	}
+	if !strings.Contains(messages[len(messages)-1], "ACTIVE again") {
+		t.Errorf("Invoke() progress = %v, want the domain to be ACTIVE", messages)
+	}
+}
+
+func TestDomainRedeemAction_InvokeFeeMismatch(t *testing.T) {
+	var consent godaddy.RedemptionConsent
+	server := fakeRedeemServer(t, &consent)
+
+	resp, _ := invokeDomainRedeem(t, server.URL, 60)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Invoke() expected an error for a fee GoDaddy doesn't charge")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "80.00 USD") {
+		t.Errorf("Invoke() error = %s, want GoDaddy's fee", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if consent.AgreedBy != "" {
+		t.Error("Invoke() redeemed the domain with a different fee")
+	}
+}
+
+func TestDomainRedeemAction_InvokeNotInRedemption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/domains/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"domain":"example.com","status":"ACTIVE"}`))
+	}))
+	defer server.Close()
+
+	resp, _ := invokeDomainRedeem(t, server.URL, 80)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Invoke() expected an error for an ACTIVE domain")
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &DomainRenewAction{}
+var _ action.ActionWithConfigure = &DomainRenewAction{}
+var _ action.ActionWithValidateConfig = &DomainRenewAction{}
+
+func NewDomainRenewAction() action.Action {
+	return &DomainRenewAction{}
+}
+
+type DomainRenewAction struct {
+	client  *godaddy.Client
+	actions *domainActionWaiter
+}
+
+type DomainRenewActionModel struct {
+	Domain   types.String  `tfsdk:"domain"`
+	Period   types.Int32   `tfsdk:"period"`
+	MaxPrice types.Float64 `tfsdk:"max_price"`
+}
+
+func (a *DomainRenewAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_renew"
+}
+
+func (a *DomainRenewAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renews a domain for additional years. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to renew.",
+				Required:            true,
+			},
+			"period": schema.Int32Attribute{
+				MarkdownDescription: "Number of years to renew the domain for (1-10).",
+				Required:            true,
+			},
+			"max_price": schema.Float64Attribute{
+				MarkdownDescription: "Highest total price, in the account currency, allowed for the renewal. It is checked against " +
+					"the list price of the domain's TLD for the period; the renewal is not made when that price is higher or unknown.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *DomainRenewAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data DomainRenewActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Period.IsNull() && !data.Period.IsUnknown() {
+		if period := data.Period.ValueInt32(); period < 1 || period > 10 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("period"),
+				"Invalid Period",
+				fmt.Sprintf("period must be between 1 and 10 years, got %d.", period),
+			)
+		}
+	}
+
+	if !data.MaxPrice.IsNull() && !data.MaxPrice.IsUnknown() && data.MaxPrice.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_price"),
+			"Invalid Max Price",
+			"max_price must be greater than zero.",
+		)
+	}
+}
+
+func (a *DomainRenewAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = providerData.Client
+	a.actions = providerData.Actions
+}
+
+func (a *DomainRenewAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DomainRenewActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	period := int(data.Period.ValueInt32())
+
+	if !data.MaxPrice.IsNull() {
+		quote, err := a.client.GetListPriceQuote(ctx, domain, period)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Checking List Price",
+				fmt.Sprintf("Could not check the list price of %s, so max_price can't be enforced: %s", domain, err),
+			)
+			return
+		}
+
+		if maxPrice := data.MaxPrice.ValueFloat64(); quote.Price > maxPrice {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_price"),
+				"List Price Too High",
+				fmt.Sprintf("The list price of %s for %d year(s) is %.2f %s, which is more than max_price (%.2f).",
+					domain, period, quote.Price, quote.Currency, maxPrice),
+			)
+			return
+		}
+
+		sendProgress(resp, fmt.Sprintf("Renewing %s for %d year(s), list price %.2f %s", domain, period, quote.Price, quote.Currency))
+	}
+
+	since := time.Now()
+	order, err := a.client.RenewDomain(ctx, domain, period)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Renewing Domain",
+			fmt.Sprintf("Could not renew domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Renewed domain", map[string]interface{}{
+		"domain":   domain,
+		"period":   period,
+		"order_id": order.OrderID,
+	})
+
+	resp.Diagnostics.Append(a.actions.wait(ctx, domain, since, godaddy.ActionRenew)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detail, err := a.client.GetDomain(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error Reading Renewed Domain",
+			fmt.Sprintf("Domain %s was renewed (order %d), but its new expiration date could not be read: %s", domain, order.OrderID, err),
+		)
+		return
+	}
+
+	expires := "unknown"
+	if detail.Expires != nil {
+		expires = detail.Expires.Format(time.RFC3339)
+	}
+	sendProgress(resp, fmt.Sprintf("Renewed %s (order %d, %.2f %s); it now expires %s",
+		domain, order.OrderID, godaddy.FromMicros(order.Total), order.Currency, expires))
+}
+
+// sendProgress reports a message to Terraform while the action runs
+func sendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
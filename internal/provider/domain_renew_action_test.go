@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeRenewServer serves the endpoints used by godaddy_domain_renew and
+// records whether a renewal was ordered.
+func fakeRenewServer(t *testing.T, price string, renewed *bool) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/domains/available":
+			w.Write([]byte(`{"domain":"example.com","available":false,"currency":"USD","period":1` + price + `}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/domains/example.com/renew":
+			*renewed = true
+			w.Write([]byte(`{"orderId":42,"itemCount":1,"total":23980000,"currency":"USD"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/domains/example.com":
+			w.Write([]byte(`{"domain":"example.com","status":"ACTIVE","expires":"2028-05-01T00:00:00Z"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func invokeDomainRenew(t *testing.T, serverURL string, maxPrice *float64) (*action.InvokeResponse, []string) {
+	t.Helper()
+
+	values := map[string]tftypes.Value{
+		"domain": tftypes.NewValue(tftypes.String, "example.com"),
+		"period": tftypes.NewValue(tftypes.Number, 2),
+	}
+	if maxPrice != nil {
+		values["max_price"] = tftypes.NewValue(tftypes.Number, *maxPrice)
+	}
+
+	return invokeAction(t, &DomainRenewAction{client: newTestClient(serverURL)}, values)
+}
+
+func TestDomainRenewAction_Invoke(t *testing.T) {
+	var renewed bool
+	server := fakeRenewServer(t, `,"price":11990000`, &renewed)
+	defer server.Close()
+
+	maxPrice := 30.0
+	resp, messages := invokeDomainRenew(t, server.URL, &maxPrice)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() diagnostics = %v", resp.Diagnostics)
+	}
+	if !renewed {
+		t.Fatal("Invoke() did not renew the domain")
+	}
+	if len(messages) == 0 || !strings.Contains(messages[len(messages)-1], "2028-05-01T00:00:00Z") {
+		t.Errorf("Invoke() progress = %v, want the new expiration date", messages)
+	}
+}
+
+func TestDomainRenewAction_InvokeWithoutMaxPrice(t *testing.T) {
+	var renewed bool
+	server := fakeRenewServer(t, "", &renewed)
+	defer server.Close()
+
+	resp, _ := invokeDomainRenew(t, server.URL, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() diagnostics = %v", resp.Diagnostics)
+	}
+	if !renewed {
+		t.Fatal("Invoke() did not renew the domain")
+	}
+}
+
+func TestDomainRenewAction_InvokePriceGuard(t *testing.T) {
+	tests := []struct {
+		name  string
+		price string
+	}{
+		{name: "price above max_price", price: `,"price":19990000`},
+		{name: "price unknown", price: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var renewed bool
+			server := fakeRenewServer(t, tt.price, &renewed)
+			defer server.Close()
+
+			maxPrice := 30.0
+			resp, _ := invokeDomainRenew(t, server.URL, &maxPrice)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Invoke() expected an error")
+			}
+			if renewed {
+				t.Error("Invoke() renewed the domain despite the price guard")
+			}
+		})
+	}
+}
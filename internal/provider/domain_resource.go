@@ -8,44 +8,79 @@ import (
 
 	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &DomainResource{}
 var _ resource.ResourceWithImportState = &DomainResource{}
+var _ resource.ResourceWithModifyPlan = &DomainResource{}
+
+// Values of outbound_transfer_policy
+const (
+	outboundTransferReject = "reject"
+	outboundTransferAccept = "accept"
+	outboundTransferManual = "manual"
+)
 
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
 }
 
 type DomainResource struct {
-	client *godaddy.Client
+	client          *godaddy.Client
+	contactProfiles map[string]ContactProfile
+	contactChecker  *contactChecker
+	actions         *domainActionWaiter
+	expiry          *domainExpiryChecker
 }
 
 type DomainResourceModel struct {
-	Domain              types.String `tfsdk:"domain"`
-	Status              types.String `tfsdk:"status"`
-	Expires             types.String `tfsdk:"expires"`
-	ExpirationProtected types.Bool   `tfsdk:"expiration_protected"`
-	HoldRegistrar       types.Bool   `tfsdk:"hold_registrar"`
-	Locked              types.Bool   `tfsdk:"locked"`
-	Privacy             types.Bool   `tfsdk:"privacy"`
-	RenewAuto           types.Bool   `tfsdk:"renew_auto"`
-	TransferProtected   types.Bool   `tfsdk:"transfer_protected"`
-	Nameservers         types.List   `tfsdk:"nameservers"`
-	ContactAdmin        types.Object `tfsdk:"contact_admin"`
-	ContactBilling      types.Object `tfsdk:"contact_billing"`
-	ContactRegistrant   types.Object `tfsdk:"contact_registrant"`
-	ContactTech         types.Object `tfsdk:"contact_tech"`
+	Domain                 types.String `tfsdk:"domain"`
+	Status                 types.String `tfsdk:"status"`
+	Expires                types.String `tfsdk:"expires"`
+	RenewDeadline          types.String `tfsdk:"renew_deadline"`
+	ExpirationProtected    types.Bool   `tfsdk:"expiration_protected"`
+	HoldRegistrar          types.Bool   `tfsdk:"hold_registrar"`
+	Locked                 types.Bool   `tfsdk:"locked"`
+	Privacy                types.Bool   `tfsdk:"privacy"`
+	RenewAuto              types.Bool   `tfsdk:"renew_auto"`
+	TransferProtected      types.Bool   `tfsdk:"transfer_protected"`
+	Nameservers            types.List   `tfsdk:"nameservers"`
+	ContactAdmin           types.Object `tfsdk:"contact_admin"`
+	ContactBilling         types.Object `tfsdk:"contact_billing"`
+	ContactRegistrant      types.Object `tfsdk:"contact_registrant"`
+	ContactTech            types.Object `tfsdk:"contact_tech"`
+	ContactProfile         types.String `tfsdk:"contact_profile"`
+	RegistrantChange       types.Object `tfsdk:"registrant_change"`
+	RegistrantChangeStatus types.String `tfsdk:"registrant_change_status"`
+
+	OutboundTransferPolicy       types.String `tfsdk:"outbound_transfer_policy"`
+	OutboundTransferRejectReason types.String `tfsdk:"outbound_transfer_reject_reason"`
+	OutboundTransferStatus       types.String `tfsdk:"outbound_transfer_status"`
 }
 
+type RegistrantChangeModel struct {
+	AgreedBy            types.String `tfsdk:"agreed_by"`
+	TransferLockOptOut  types.Bool   `tfsdk:"transfer_lock_opt_out"`
+	WaitForConfirmation types.Bool   `tfsdk:"wait_for_confirmation"`
+	ConfirmationTimeout types.String `tfsdk:"confirmation_timeout"`
+}
+
+// defaultRegistrantChangeTimeout is how long to wait for a change of
+// registrant to be confirmed when no timeout is configured.
+const defaultRegistrantChangeTimeout = 30 * time.Minute
+
 func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_domain"
 }
@@ -69,6 +104,13 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The expiration date of the domain.",
 				Computed:            true,
 			},
+			"renew_deadline": schema.StringAttribute{
+				MarkdownDescription: "Last date on which the domain can be renewed normally (RFC 3339), if GoDaddy reports one.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"expiration_protected": schema.BoolAttribute{
 				MarkdownDescription: "Whether the domain is protected from expiration.",
 				Optional:            true,
@@ -131,69 +173,104 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Attributes:          contactAttributes(),
 			},
+			"contact_profile": schema.StringAttribute{
+				MarkdownDescription: "Name of a provider `contact_profiles` entry to take contacts from. " +
+					"Contact blocks set on the resource take precedence over the profile.",
+				Optional: true,
+			},
+			"registrant_change": schema.SingleNestedAttribute{
+				MarkdownDescription: "Consent for an ICANN change of registrant. When set, registrant name, organization or " +
+					"email changes go through GoDaddy's v2 change-of-registrant flow (requires the provider `customer_id`).",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"agreed_by": schema.StringAttribute{
+						MarkdownDescription: "Who agrees to the change, usually the IP address of the person applying it.",
+						Required:            true,
+					},
+					"transfer_lock_opt_out": schema.BoolAttribute{
+						MarkdownDescription: "Opt out of the 60-day transfer lock that follows a change of registrant.",
+						Optional:            true,
+					},
+					"wait_for_confirmation": schema.BoolAttribute{
+						MarkdownDescription: "Wait until the change of registrant has been confirmed before finishing the apply.",
+						Optional:            true,
+					},
+					"confirmation_timeout": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for confirmation, as a Go duration (e.g. `2h`). Defaults to `30m`.",
+						Optional:            true,
+					},
+				},
+			},
+			"registrant_change_status": schema.StringAttribute{
+				MarkdownDescription: "Status of a change of registrant awaiting confirmation, or null when none is pending.",
+				Computed:            true,
+			},
+			"outbound_transfer_policy": schema.StringAttribute{
+				MarkdownDescription: "What to do with a pending transfer of the domain to another registrar: `reject`, `accept` or " +
+					"`manual` (leave it for the GoDaddy UI). Applied on every apply and refresh. `reject` and `accept` require " +
+					"the provider `customer_id`. Defaults to `manual`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(outboundTransferManual),
+				Validators: []validator.String{
+					StringOneOfValidator(outboundTransferReject, outboundTransferAccept, outboundTransferManual),
+				},
+			},
+			"outbound_transfer_reject_reason": schema.StringAttribute{
+				MarkdownDescription: "Reason given to the registry when rejecting an outbound transfer. Defaults to `WRITTEN_OBJECTION`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(godaddy.TransferOutRejectWrittenObjection),
+				Validators: []validator.String{
+					StringOneOfValidator(godaddy.ValidTransferOutRejectReasons()...),
+				},
+			},
+			"outbound_transfer_status": schema.StringAttribute{
+				MarkdownDescription: "Outcome for a pending outbound transfer seen by the last apply or refresh: `PENDING` when " +
+					"left for manual handling, `ACCEPTED` or `REJECTED` when the provider acted on it, or null when none was pending.",
+				Computed: true,
+			},
 		},
 	}
 }
 
+// contactField describes one attribute of a contact block. The contact
+// attributes of every schema are built from contactFields.
+type contactField struct {
+	name        string
+	description string
+	required    bool
+	validators  []validator.String
+}
+
+var contactFields = []contactField{
+	{name: "name_first", description: "First name.", required: true},
+	{name: "name_middle", description: "Middle name."},
+	{name: "name_last", description: "Last name.", required: true},
+	{name: "organization", description: "Organization name."},
+	{name: "job_title", description: "Job title."},
+	{name: "email", description: "Email address.", required: true, validators: []validator.String{ContactEmailValidator()}},
+	{name: "phone", description: "Phone number.", required: true, validators: []validator.String{ContactPhoneValidator()}},
+	{name: "fax", description: "Fax number.", validators: []validator.String{ContactPhoneValidator()}},
+	{name: "address1", description: "Address line 1.", required: true},
+	{name: "address2", description: "Address line 2."},
+	{name: "city", description: "City.", required: true},
+	{name: "state", description: "State or province.", required: true},
+	{name: "postal_code", description: "Postal code.", required: true},
+	{name: "country", description: "Country code (2-letter ISO).", required: true, validators: []validator.String{CountryCodeValidator()}},
+}
+
 func contactAttributes() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
-		"name_first": schema.StringAttribute{
-			MarkdownDescription: "First name.",
-			Required:            true,
-		},
-		"name_middle": schema.StringAttribute{
-			MarkdownDescription: "Middle name.",
-			Optional:            true,
-		},
-		"name_last": schema.StringAttribute{
-			MarkdownDescription: "Last name.",
-			Required:            true,
-		},
-		"organization": schema.StringAttribute{
-			MarkdownDescription: "Organization name.",
-			Optional:            true,
-		},
-		"job_title": schema.StringAttribute{
-			MarkdownDescription: "Job title.",
-			Optional:            true,
-		},
-		"email": schema.StringAttribute{
-			MarkdownDescription: "Email address.",
-			Required:            true,
-		},
-		"phone": schema.StringAttribute{
-			MarkdownDescription: "Phone number.",
-			Required:            true,
-		},
-		"fax": schema.StringAttribute{
-			MarkdownDescription: "Fax number.",
-			Optional:            true,
-		},
-		"address1": schema.StringAttribute{
-			MarkdownDescription: "Address line 1.",
-			Required:            true,
-		},
-		"address2": schema.StringAttribute{
-			MarkdownDescription: "Address line 2.",
-			Optional:            true,
-		},
-		"city": schema.StringAttribute{
-			MarkdownDescription: "City.",
-			Required:            true,
-		},
-		"state": schema.StringAttribute{
-			MarkdownDescription: "State or province.",
-			Required:            true,
-		},
-		"postal_code": schema.StringAttribute{
-			MarkdownDescription: "Postal code.",
-			Required:            true,
-		},
-		"country": schema.StringAttribute{
-			MarkdownDescription: "Country code (2-letter ISO).",
-			Required:            true,
-		},
+	attributes := make(map[string]schema.Attribute, len(contactFields))
+	for _, field := range contactFields {
+		attributes[field.name] = schema.StringAttribute{
+			MarkdownDescription: field.description,
+			Required:            field.required,
+			Optional:            !field.required,
+			Validators:          field.validators,
+		}
 	}
+	return attributes
 }
 
 func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -201,16 +278,231 @@ func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*godaddy.Client)
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *godaddy.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.contactProfiles = providerData.ContactProfiles
+	r.contactChecker = newContactChecker(providerData)
+	r.actions = providerData.Actions
+	r.expiry = providerData.Expiry
+}
+
+func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan DomainResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider is not configured yet during validation-only runs
+	if r.contactProfiles == nil {
+		return
+	}
+
+	if policy := plan.OutboundTransferPolicy.ValueString(); policy != outboundTransferManual &&
+		!plan.OutboundTransferPolicy.IsUnknown() && !r.client.HasCustomerID() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("outbound_transfer_policy"),
+			"Missing Customer ID",
+			fmt.Sprintf("outbound_transfer_policy = %q uses the v2 domains API and requires customer_id to be set on the provider.", policy),
+		)
+		return
+	}
+
+	// Refreshing only warns about a domain past its renewal deadline, so that
+	// it can still be destroyed or removed; keeping it configured fails
+	if !req.State.Raw.IsNull() {
+		var state DomainResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.expiry.checkDeadline(domainFromState(state))...)
+	}
+
+	// Contacts that will be sent to GoDaddy, keyed by attribute name
+	managed := map[string]*types.Object{}
+	if !config.ContactAdmin.IsNull() {
+		managed["contact_admin"] = &plan.ContactAdmin
+	}
+	if !config.ContactBilling.IsNull() {
+		managed["contact_billing"] = &plan.ContactBilling
+	}
+	if !config.ContactRegistrant.IsNull() {
+		managed["contact_registrant"] = &plan.ContactRegistrant
+	}
+	if !config.ContactTech.IsNull() {
+		managed["contact_tech"] = &plan.ContactTech
+	}
+
+	if !config.ContactProfile.IsNull() && !config.ContactProfile.IsUnknown() {
+		name := config.ContactProfile.ValueString()
+		profile, ok := r.contactProfiles[name]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("contact_profile"),
+				"Unknown Contact Profile",
+				fmt.Sprintf("No contact profile named %q is defined in the provider configuration.", name),
+			)
+			return
+		}
+
+		// Profile contacts fill in the roles that are not configured on the
+		// resource itself, so a profile change plans an update here.
+		if config.ContactAdmin.IsNull() && profile.Admin != nil {
+			plan.ContactAdmin = contactToObject(*profile.Admin)
+			managed["contact_admin"] = &plan.ContactAdmin
+		}
+		if config.ContactBilling.IsNull() && profile.Billing != nil {
+			plan.ContactBilling = contactToObject(*profile.Billing)
+			managed["contact_billing"] = &plan.ContactBilling
+		}
+		if config.ContactRegistrant.IsNull() && profile.Registrant != nil {
+			plan.ContactRegistrant = contactToObject(*profile.Registrant)
+			managed["contact_registrant"] = &plan.ContactRegistrant
+		}
+		if config.ContactTech.IsNull() && profile.Tech != nil {
+			plan.ContactTech = contactToObject(*profile.Tech)
+			managed["contact_tech"] = &plan.ContactTech
+		}
+	}
+
+	resp.Diagnostics.Append(r.planRegistrantChange(ctx, req, &plan)...)
+	resp.Diagnostics.Append(planOutboundTransferStatus(ctx, req, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Domain.IsUnknown() && len(managed) > 0 {
+		contacts := make(map[string]types.Object, len(managed))
+		for name, obj := range managed {
+			contacts[name] = *obj
+		}
+		resp.Diagnostics.Append(r.contactChecker.check(ctx, plan.Domain.ValueString(), contacts)...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// planRegistrantChange reports a change of registrant in the plan and keeps
+// the pending status unchanged when the registrant is not changing.
+func (r *DomainResource) planRegistrantChange(ctx context.Context, req resource.ModifyPlanRequest, plan *DomainResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	change, d := registrantChangeFromModel(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if change != nil {
+		if !change.ConfirmationTimeout.IsNull() && !change.ConfirmationTimeout.IsUnknown() {
+			if _, err := time.ParseDuration(change.ConfirmationTimeout.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("registrant_change").AtName("confirmation_timeout"),
+					"Invalid Confirmation Timeout",
+					fmt.Sprintf("Could not parse %q as a duration: %s", change.ConfirmationTimeout.ValueString(), err),
+				)
+			}
+		}
+		if r.client != nil && !r.client.HasCustomerID() {
+			diags.AddAttributeError(
+				path.Root("registrant_change"),
+				"Missing Customer ID",
+				"The change-of-registrant flow uses the GoDaddy v2 API. Set customer_id in the provider configuration.",
+			)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var state DomainResourceModel
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if state.ContactRegistrant.IsNull() || plan.ContactRegistrant.IsNull() || plan.ContactRegistrant.IsUnknown() ||
+		contactHasUnknowns(plan.ContactRegistrant) ||
+		!isChangeOfRegistrant(*normalizedContact(state.ContactRegistrant), *normalizedContact(plan.ContactRegistrant)) {
+		plan.RegistrantChangeStatus = state.RegistrantChangeStatus
+		return diags
+	}
+
+	detail := "Without registrant_change, the contacts are updated directly and GoDaddy decides how the change is confirmed."
+	if change != nil {
+		detail = "The change will be submitted with your consent; it takes effect once the registrant confirms it."
+		if change.TransferLockOptOut.ValueBool() {
+			detail += " You opted out of the 60-day transfer lock."
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("contact_registrant"),
+		"Change of Registrant",
+		fmt.Sprintf("This plan changes the registrant name, organization or email of %s. "+
+			"Under ICANN transfer policy this is a change of registrant, which locks the domain against transfers "+
+			"to another registrar for 60 days unless you opt out. %s", plan.Domain.ValueString(), detail),
+	)
+
+	return diags
+}
+
+// domainFromState returns what the expiry checks need from the state of a
+// domain.
+func domainFromState(state DomainResourceModel) godaddy.Domain {
+	domain := godaddy.Domain{
+		Domain:    state.Domain.ValueString(),
+		RenewAuto: state.RenewAuto.ValueBool(),
+	}
+	if deadline, err := time.Parse(time.RFC3339, state.RenewDeadline.ValueString()); err == nil {
+		domain.RenewDeadline = &deadline
+	}
+	return domain
+}
+
+// planOutboundTransferStatus keeps outbound_transfer_status from the state
+// when applying the plan can't change it: the policy is unchanged and the
+// last refresh found no transfer, or left it pending. A transfer the provider
+// accepted or rejected may have moved on, so its status stays unknown.
+func planOutboundTransferStatus(ctx context.Context, req resource.ModifyPlanRequest, plan *DomainResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var state DomainResourceModel
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !plan.OutboundTransferPolicy.Equal(state.OutboundTransferPolicy) ||
+		!plan.OutboundTransferRejectReason.Equal(state.OutboundTransferRejectReason) {
+		return diags
+	}
+
+	if state.OutboundTransferStatus.IsNull() || state.OutboundTransferStatus.ValueString() == "PENDING" {
+		plan.OutboundTransferStatus = state.OutboundTransferStatus
+	}
+
+	return diags
 }
 
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -235,7 +527,9 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	r.updateModelFromDomain(&data, domain)
 
 	// Apply any configuration changes
-	if err := r.applyDomainSettings(ctx, &data, domain); err != nil {
+	since := time.Now()
+	started, err := r.applyDomainSettings(ctx, &data, domain)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Domain Settings",
 			fmt.Sprintf("Could not update domain settings for %s: %s", data.Domain.ValueString(), err),
@@ -243,6 +537,14 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.actions.wait(ctx, data.Domain.ValueString(), since, started...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readRegistrantChangeStatus(ctx, &data)
+	resp.Diagnostics.Append(r.handleOutboundTransfer(ctx, &data, domain)...)
+
 	tflog.Trace(ctx, "created domain resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -268,8 +570,19 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Imported resources have no configuration defaults yet
+	if data.OutboundTransferPolicy.IsNull() {
+		data.OutboundTransferPolicy = types.StringValue(outboundTransferManual)
+	}
+	if data.OutboundTransferRejectReason.IsNull() {
+		data.OutboundTransferRejectReason = types.StringValue(godaddy.TransferOutRejectWrittenObjection)
+	}
+
 	r.updateModelFromDomain(&data, domain)
+	r.readRegistrantChangeStatus(ctx, &data)
+	resp.Diagnostics.Append(r.handleOutboundTransfer(ctx, &data, domain)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.expiry.check(domain.Domain)...)
 }
 
 func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -289,7 +602,9 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if err := r.applyDomainSettings(ctx, &data, domain); err != nil {
+	since := time.Now()
+	started, err := r.applyDomainSettings(ctx, &data, domain)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Domain Settings",
 			fmt.Sprintf("Could not update domain settings for %s: %s", data.Domain.ValueString(), err),
@@ -297,6 +612,11 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.actions.wait(ctx, data.Domain.ValueString(), since, started...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Read back the updated domain
 	domain, err = r.client.GetDomain(ctx, data.Domain.ValueString())
 	if err != nil {
@@ -308,6 +628,8 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	r.updateModelFromDomain(&data, domain)
+	r.readRegistrantChangeStatus(ctx, &data)
+	resp.Diagnostics.Append(r.handleOutboundTransfer(ctx, &data, domain)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -335,6 +657,7 @@ func (r *DomainResource) updateModelFromDomain(model *DomainResourceModel, domai
 	if domain.Expires != nil {
 		model.Expires = types.StringValue(domain.Expires.Format(time.RFC3339))
 	}
+	model.RenewDeadline = optionalTime(domain.RenewDeadline)
 
 	model.ExpirationProtected = types.BoolValue(domain.ExpirationProtected)
 	model.HoldRegistrar = types.BoolValue(domain.HoldRegistrar)
@@ -352,16 +675,19 @@ func (r *DomainResource) updateModelFromDomain(model *DomainResourceModel, domai
 		model.Nameservers = types.ListValueMust(types.StringType, convertStringsToValues(nameservers))
 	}
 
-	// Convert contacts
-	model.ContactAdmin = contactToObject(domain.ContactAdmin)
-	model.ContactBilling = contactToObject(domain.ContactBilling)
-	model.ContactRegistrant = contactToObject(domain.ContactRegistrant)
-	model.ContactTech = contactToObject(domain.ContactTech)
+	// Convert contacts, keeping the configured formatting of equivalent values
+	model.ContactAdmin = preserveContactFormat(model.ContactAdmin, domain.ContactAdmin, contactToObject)
+	model.ContactBilling = preserveContactFormat(model.ContactBilling, domain.ContactBilling, contactToObject)
+	model.ContactRegistrant = preserveContactFormat(model.ContactRegistrant, domain.ContactRegistrant, contactToObject)
+	model.ContactTech = preserveContactFormat(model.ContactTech, domain.ContactTech, contactToObject)
 }
 
-func (r *DomainResource) applyDomainSettings(ctx context.Context, model *DomainResourceModel, currentDomain *godaddy.DomainDetail) error {
+// applyDomainSettings updates the domain to match model and returns the
+// types of the registry actions started by the changes.
+func (r *DomainResource) applyDomainSettings(ctx context.Context, model *DomainResourceModel, currentDomain *godaddy.DomainDetail) ([]string, error) {
 	update := godaddy.DomainUpdate{}
 	needsUpdate := false
+	var started []string
 
 	// Check if locked state needs update
 	if !model.Locked.IsNull() && model.Locked.ValueBool() != currentDomain.Locked {
@@ -390,24 +716,37 @@ func (r *DomainResource) applyDomainSettings(ctx context.Context, model *DomainR
 
 	if needsUpdate {
 		if err := r.client.UpdateDomain(ctx, model.Domain.ValueString(), update); err != nil {
-			return err
+			return nil, err
+		}
+		if update.Locked != nil || update.RenewAuto != nil {
+			started = append(started, godaddy.ActionDomainUpdate)
+		}
+		if update.NameServers != nil {
+			started = append(started, godaddy.ActionDomainUpdateNameServers)
 		}
 	}
 
 	// Update contacts if provided
-	if err := r.updateContacts(ctx, model, currentDomain); err != nil {
-		return err
+	contactsUpdated, err := r.updateContacts(ctx, model, currentDomain)
+	if err != nil {
+		return nil, err
+	}
+	if contactsUpdated {
+		started = append(started, godaddy.ActionDomainUpdateContacts)
 	}
 
-	return nil
+	return started, nil
 }
 
-func (r *DomainResource) updateContacts(ctx context.Context, model *DomainResourceModel, currentDomain *godaddy.DomainDetail) error {
+// updateContacts updates the contacts that differ from currentDomain. It
+// reports whether a plain contacts update was made; changes of registrant are
+// confirmed separately and never reported.
+func (r *DomainResource) updateContacts(ctx context.Context, model *DomainResourceModel, currentDomain *godaddy.DomainDetail) (bool, error) {
 	contacts := godaddy.DomainContacts{}
 	needsUpdate := false
 
 	if !model.ContactAdmin.IsNull() {
-		contact := objectToContact(model.ContactAdmin)
+		contact := normalizedContact(model.ContactAdmin)
 		if contact != nil && !contactsEqual(*contact, currentDomain.ContactAdmin) {
 			contacts.ContactAdmin = contact
 			needsUpdate = true
@@ -415,7 +754,7 @@ func (r *DomainResource) updateContacts(ctx context.Context, model *DomainResour
 	}
 
 	if !model.ContactBilling.IsNull() {
-		contact := objectToContact(model.ContactBilling)
+		contact := normalizedContact(model.ContactBilling)
 		if contact != nil && !contactsEqual(*contact, currentDomain.ContactBilling) {
 			contacts.ContactBilling = contact
 			needsUpdate = true
@@ -423,7 +762,7 @@ func (r *DomainResource) updateContacts(ctx context.Context, model *DomainResour
 	}
 
 	if !model.ContactRegistrant.IsNull() {
-		contact := objectToContact(model.ContactRegistrant)
+		contact := normalizedContact(model.ContactRegistrant)
 		if contact != nil && !contactsEqual(*contact, currentDomain.ContactRegistrant) {
 			contacts.ContactRegistrant = contact
 			needsUpdate = true
@@ -431,20 +770,206 @@ func (r *DomainResource) updateContacts(ctx context.Context, model *DomainResour
 	}
 
 	if !model.ContactTech.IsNull() {
-		contact := objectToContact(model.ContactTech)
+		contact := normalizedContact(model.ContactTech)
 		if contact != nil && !contactsEqual(*contact, currentDomain.ContactTech) {
 			contacts.ContactTech = contact
 			needsUpdate = true
 		}
 	}
 
-	if needsUpdate {
-		return r.client.UpdateDomainContacts(ctx, model.Domain.ValueString(), contacts)
+	if !needsUpdate {
+		return false, nil
+	}
+
+	change, diags := registrantChangeFromModel(ctx, model)
+	if diags.HasError() {
+		return false, fmt.Errorf("invalid registrant_change")
+	}
+
+	if change != nil && contacts.ContactRegistrant != nil &&
+		isChangeOfRegistrant(currentDomain.ContactRegistrant, *contacts.ContactRegistrant) {
+		return false, r.changeRegistrant(ctx, model.Domain.ValueString(), contacts, change)
+	}
+
+	if err := r.client.UpdateDomainContacts(ctx, model.Domain.ValueString(), contacts); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// changeRegistrant submits the contacts through the v2 change-of-registrant
+// flow and optionally waits for the change to be confirmed.
+func (r *DomainResource) changeRegistrant(ctx context.Context, domain string, contacts godaddy.DomainContacts, change *RegistrantChangeModel) error {
+	update := godaddy.DomainContactsUpdateV2{
+		ContactAdmin:      contacts.ContactAdmin,
+		ContactBilling:    contacts.ContactBilling,
+		ContactRegistrant: contacts.ContactRegistrant,
+		ContactTech:       contacts.ContactTech,
+		Consent: &godaddy.ChangeOfRegistrantConsent{
+			AgreedAt:           time.Now().UTC().Format(time.RFC3339),
+			AgreedBy:           change.AgreedBy.ValueString(),
+			TransferLockOptOut: change.TransferLockOptOut.ValueBool(),
+		},
+	}
+
+	tflog.Info(ctx, "Submitting change of registrant", map[string]interface{}{
+		"domain":             domain,
+		"transferLockOptOut": update.Consent.TransferLockOptOut,
+	})
+
+	if err := r.client.UpdateDomainContactsV2(ctx, domain, update); err != nil {
+		return err
 	}
 
+	if !change.WaitForConfirmation.ValueBool() {
+		return nil
+	}
+
+	timeout := defaultRegistrantChangeTimeout
+	if !change.ConfirmationTimeout.IsNull() {
+		parsed, err := time.ParseDuration(change.ConfirmationTimeout.ValueString())
+		if err != nil {
+			return fmt.Errorf("invalid confirmation_timeout: %w", err)
+		}
+		timeout = parsed
+	}
+
+	err := godaddy.WaitFor(ctx, godaddy.WaitOptions{Timeout: timeout}, func(ctx context.Context) (bool, error) {
+		pending, err := r.client.GetChangeOfRegistrant(ctx, domain)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				// No pending change left: it has been confirmed
+				return true, nil
+			}
+			return false, err
+		}
+
+		tflog.Debug(ctx, "Waiting for change of registrant confirmation", map[string]interface{}{
+			"domain": domain,
+			"status": pending.Status,
+		})
+		return !pending.IsPending(), nil
+	})
+	if err != nil {
+		return fmt.Errorf("change of registrant for %s was not confirmed: %w", domain, err)
+	}
 	return nil
 }
 
+// readRegistrantChangeStatus sets the status of a pending change of
+// registrant. It is left null when the v2 API is not configured.
+func (r *DomainResource) readRegistrantChangeStatus(ctx context.Context, model *DomainResourceModel) {
+	model.RegistrantChangeStatus = types.StringNull()
+	if !r.client.HasCustomerID() {
+		return
+	}
+
+	pending, err := r.client.GetChangeOfRegistrant(ctx, model.Domain.ValueString())
+	if err != nil {
+		if !strings.Contains(err.Error(), "404") {
+			tflog.Warn(ctx, "Could not read change of registrant status", map[string]interface{}{
+				"domain": model.Domain.ValueString(),
+				"error":  err.Error(),
+			})
+		}
+		return
+	}
+
+	if pending.IsPending() {
+		model.RegistrantChangeStatus = types.StringValue(pending.Status)
+	}
+}
+
+// handleOutboundTransfer applies outbound_transfer_policy to a pending
+// transfer of the domain to another registrar and reports the outcome as a
+// warning and in outbound_transfer_status.
+func (r *DomainResource) handleOutboundTransfer(ctx context.Context, model *DomainResourceModel, domain *godaddy.DomainDetail) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.OutboundTransferStatus = types.StringNull()
+	if !godaddy.IsPendingTransferOut(domain.Status) {
+		return diags
+	}
+
+	name := model.Domain.ValueString()
+	policy := model.OutboundTransferPolicy.ValueString()
+	if policy != outboundTransferManual && !r.client.HasCustomerID() {
+		diags.AddWarning(
+			"Outbound Transfer Not Handled",
+			fmt.Sprintf("Domain %s has a pending outbound transfer, but outbound_transfer_policy = %q requires customer_id to be set on the provider.", name, policy),
+		)
+		policy = outboundTransferManual
+	}
+
+	switch policy {
+	case outboundTransferReject:
+		reason := model.OutboundTransferRejectReason.ValueString()
+		since := time.Now()
+		if err := r.client.RejectDomainTransferOut(ctx, name, reason); err != nil {
+			diags.AddError(
+				"Error Rejecting Outbound Transfer",
+				fmt.Sprintf("Could not reject the pending outbound transfer of %s: %s", name, err),
+			)
+			return diags
+		}
+		diags.Append(r.actions.wait(ctx, name, since, godaddy.ActionTransferOutReject)...)
+		if diags.HasError() {
+			return diags
+		}
+		model.OutboundTransferStatus = types.StringValue("REJECTED")
+		diags.AddWarning(
+			"Outbound Transfer Rejected",
+			fmt.Sprintf("Rejected a pending transfer of %s to another registrar (reason %s).", name, reason),
+		)
+
+	case outboundTransferAccept:
+		since := time.Now()
+		if err := r.client.AcceptDomainTransferOut(ctx, name); err != nil {
+			diags.AddError(
+				"Error Accepting Outbound Transfer",
+				fmt.Sprintf("Could not accept the pending outbound transfer of %s: %s", name, err),
+			)
+			return diags
+		}
+		diags.Append(r.actions.wait(ctx, name, since, godaddy.ActionTransferOutAccept)...)
+		if diags.HasError() {
+			return diags
+		}
+		model.OutboundTransferStatus = types.StringValue("ACCEPTED")
+		diags.AddWarning(
+			"Outbound Transfer Accepted",
+			fmt.Sprintf("Accepted a pending transfer of %s to another registrar. The domain will leave the account once the transfer completes.", name),
+		)
+
+	default:
+		model.OutboundTransferStatus = types.StringValue("PENDING")
+		diags.AddWarning(
+			"Outbound Transfer Pending",
+			fmt.Sprintf("Domain %s has a pending transfer to another registrar. Accept or reject it in the GoDaddy UI, or set outbound_transfer_policy.", name),
+		)
+	}
+
+	tflog.Info(ctx, "Handled pending outbound transfer", map[string]interface{}{
+		"domain": name,
+		"status": model.OutboundTransferStatus.ValueString(),
+	})
+
+	return diags
+}
+
+func registrantChangeFromModel(ctx context.Context, model *DomainResourceModel) (*RegistrantChangeModel, diag.Diagnostics) {
+	if model.RegistrantChange.IsNull() || model.RegistrantChange.IsUnknown() {
+		return nil, nil
+	}
+
+	var change RegistrantChangeModel
+	diags := model.RegistrantChange.As(ctx, &change, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &change, diags
+}
+
 func convertStringsToValues(strings []types.String) []attr.Value {
 	values := make([]attr.Value, len(strings))
 	for i, s := range strings {
@@ -487,7 +1012,7 @@ func contactToObject(contact godaddy.DomainContact) types.Object {
 }
 
 func objectToContact(obj types.Object) *godaddy.DomainContact {
-	if obj.IsNull() {
+	if obj.IsNull() || obj.IsUnknown() {
 		return nil
 	}
 
@@ -512,23 +1037,23 @@ func objectToContact(obj types.Object) *godaddy.DomainContact {
 	}
 }
 
+// normalizedContact converts a contact object to the form GoDaddy stores,
+// so it can be compared with and sent to the API.
+func normalizedContact(obj types.Object) *godaddy.DomainContact {
+	contact := objectToContact(obj)
+	if contact == nil {
+		return nil
+	}
+	normalized := godaddy.NormalizeContact(*contact)
+	return &normalized
+}
+
 func contactAttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"name_first":   types.StringType,
-		"name_middle":  types.StringType,
-		"name_last":    types.StringType,
-		"organization": types.StringType,
-		"job_title":    types.StringType,
-		"email":        types.StringType,
-		"phone":        types.StringType,
-		"fax":          types.StringType,
-		"address1":     types.StringType,
-		"address2":     types.StringType,
-		"city":         types.StringType,
-		"state":        types.StringType,
-		"postal_code":  types.StringType,
-		"country":      types.StringType,
+	attrTypes := make(map[string]attr.Type, len(contactFields))
+	for _, field := range contactFields {
+		attrTypes[field.name] = types.StringType
 	}
+	return attrTypes
 }
 
 func contactsEqual(a, b godaddy.DomainContact) bool {
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainResource_PlanContactProfile(t *testing.T) {
+	ctx := context.Background()
+	jane := testContact("Jane", "jane@example.com")
+	tech := testContact("Tech", "tech@example.com")
+	r := &DomainResource{
+		client: godaddy.NewClient("test-key", "test-secret"),
+		contactProfiles: map[string]ContactProfile{
+			"corp": {Registrant: &jane, Tech: &tech},
+		},
+	}
+
+	t.Run("create", func(t *testing.T) {
+		other := testContact("Other", "other@example.com")
+		resp := planResource(t, r, tfsdk.State{}, map[string]tftypes.Value{
+			"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+			"contact_profile": tftypes.NewValue(tftypes.String, "corp"),
+			"contact_tech":    contactValue(t, other),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+		}
+
+		var plan DomainResourceModel
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+		if registrant := objectToContact(plan.ContactRegistrant); registrant == nil || registrant.Email != jane.Email {
+			t.Errorf("contact_registrant = %s, want the profile registrant", plan.ContactRegistrant)
+		}
+		if contact := objectToContact(plan.ContactTech); contact == nil || contact.Email != other.Email {
+			t.Errorf("contact_tech = %s, want the resource contact to take precedence", plan.ContactTech)
+		}
+		if !plan.ContactAdmin.IsUnknown() {
+			t.Errorf("contact_admin = %s, want unknown for a role the profile leaves out", plan.ContactAdmin)
+		}
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		resp := planResource(t, r, tfsdk.State{}, map[string]tftypes.Value{
+			"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+			"contact_profile": tftypes.NewValue(tftypes.String, "missing"),
+		})
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("ModifyPlan() expected an error for an undefined profile")
+		}
+	})
+
+	tests := []struct {
+		name       string
+		profile    godaddy.DomainContact
+		wantChange bool
+	}{
+		{name: "profile unchanged", profile: jane, wantChange: false},
+		{name: "profile changed", profile: func() godaddy.DomainContact {
+			c := jane
+			c.Phone = "+1.5555550000"
+			return c
+		}(), wantChange: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"domain":                   tftypes.NewValue(tftypes.String, "example.com"),
+				"contact_profile":          tftypes.NewValue(tftypes.String, "corp"),
+				"contact_registrant":       contactObjectValue(t, jane),
+				"outbound_transfer_policy": tftypes.NewValue(tftypes.String, outboundTransferManual),
+			})
+			updated := &DomainResource{
+				client:          r.client,
+				contactProfiles: map[string]ContactProfile{"corp": {Registrant: &tt.profile}},
+			}
+
+			resp := planResource(t, updated, state, map[string]tftypes.Value{
+				"domain":                   tftypes.NewValue(tftypes.String, "example.com"),
+				"contact_profile":          tftypes.NewValue(tftypes.String, "corp"),
+				"outbound_transfer_policy": tftypes.NewValue(tftypes.String, outboundTransferManual),
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var plan, prior DomainResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			resp.Diagnostics.Append(state.Get(ctx, &prior)...)
+			if changed := !plan.ContactRegistrant.Equal(prior.ContactRegistrant); changed != tt.wantChange {
+				t.Errorf("contact_registrant changed = %v, want %v (plan %s)", changed, tt.wantChange, plan.ContactRegistrant)
+			}
+		})
+	}
+}
+
+// contactObjectValue converts contact like contactToObject, the form
+// godaddy_domain keeps contacts in.
+func contactObjectValue(t *testing.T, contact godaddy.DomainContact) tftypes.Value {
+	t.Helper()
+	value, err := contactToObject(contact).ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("could not convert contact: %s", err)
+	}
+	return value
+}
+
+func TestDomainResource_PlanOutboundTransferStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		stateStatus tftypes.Value
+		statePolicy string
+		planPolicy  string
+		wantKnown   bool
+	}{
+		{name: "none pending", stateStatus: tftypes.NewValue(tftypes.String, nil),
+			statePolicy: outboundTransferReject, planPolicy: outboundTransferReject, wantKnown: true},
+		{name: "left pending", stateStatus: tftypes.NewValue(tftypes.String, "PENDING"),
+			statePolicy: outboundTransferManual, planPolicy: outboundTransferManual, wantKnown: true},
+		{name: "policy changed", stateStatus: tftypes.NewValue(tftypes.String, "PENDING"),
+			statePolicy: outboundTransferManual, planPolicy: outboundTransferAccept},
+		{name: "acted on", stateStatus: tftypes.NewValue(tftypes.String, "REJECTED"),
+			statePolicy: outboundTransferReject, planPolicy: outboundTransferReject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainResource{
+				client:          newTestClient("http://127.0.0.1:0"),
+				contactProfiles: map[string]ContactProfile{},
+			}
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"domain":                          tftypes.NewValue(tftypes.String, "example.com"),
+				"locked":                          tftypes.NewValue(tftypes.Bool, true),
+				"outbound_transfer_policy":        tftypes.NewValue(tftypes.String, tt.statePolicy),
+				"outbound_transfer_reject_reason": tftypes.NewValue(tftypes.String, "WRITTEN_OBJECTION"),
+				"outbound_transfer_status":        tt.stateStatus,
+			})
+			config := map[string]tftypes.Value{
+				"domain":                          tftypes.NewValue(tftypes.String, "example.com"),
+				"locked":                          tftypes.NewValue(tftypes.Bool, false),
+				"outbound_transfer_policy":        tftypes.NewValue(tftypes.String, tt.planPolicy),
+				"outbound_transfer_reject_reason": tftypes.NewValue(tftypes.String, "WRITTEN_OBJECTION"),
+			}
+			// Terraform marks computed attributes unknown when an update is planned
+			planned := planResource(t, r, state, config)
+			if planned.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", planned.Diagnostics)
+			}
+
+			var plan, prior DomainResourceModel
+			planned.Diagnostics.Append(planned.Plan.Get(context.Background(), &plan)...)
+			state.Get(context.Background(), &prior)
+			if known := !plan.OutboundTransferStatus.IsUnknown(); known != tt.wantKnown {
+				t.Fatalf("outbound_transfer_status = %s, want known %v", plan.OutboundTransferStatus, tt.wantKnown)
+			}
+			if tt.wantKnown && !plan.OutboundTransferStatus.Equal(prior.OutboundTransferStatus) {
+				t.Errorf("outbound_transfer_status = %s, want %s from the state", plan.OutboundTransferStatus, prior.OutboundTransferStatus)
+			}
+		})
+	}
+}
+
+func TestDomainResource_HandleOutboundTransferWaits(t *testing.T) {
+	tests := []struct {
+		policy     string
+		path       string
+		action     string
+		wantStatus string
+	}{
+		{policy: outboundTransferReject, path: "transferOutReject", action: godaddy.ActionTransferOutReject, wantStatus: "REJECTED"},
+		{policy: outboundTransferAccept, path: "transferOutAccept", action: godaddy.ActionTransferOutAccept, wantStatus: "ACCEPTED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			for _, actionStatus := range []string{godaddy.ActionStatusSuccess, godaddy.ActionStatusFailed} {
+				created := time.Now().UTC().Add(time.Second).Format(time.RFC3339)
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch {
+					case r.Method == http.MethodPost && r.URL.Path == "/v2/customers/cust-1/domains/example.com/"+tt.path:
+						w.WriteHeader(http.StatusNoContent)
+					case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com/actions/"+tt.action:
+						w.Write([]byte(`{"type":"` + tt.action + `","status":"` + actionStatus + `","createdAt":"` + created + `"}`))
+					default:
+						t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}))
+				defer server.Close()
+
+				client := newTestClient(server.URL)
+				r := &DomainResource{
+					client:  client,
+					actions: &domainActionWaiter{client: client, opts: godaddy.WaitOptions{Timeout: time.Second, Interval: time.Millisecond}},
+				}
+				model := DomainResourceModel{
+					Domain:                       types.StringValue("example.com"),
+					OutboundTransferPolicy:       types.StringValue(tt.policy),
+					OutboundTransferRejectReason: types.StringValue(godaddy.TransferOutRejectWrittenObjection),
+				}
+
+				diags := r.handleOutboundTransfer(context.Background(), &model, &godaddy.DomainDetail{
+					Domain: godaddy.Domain{Domain: "example.com", Status: "PENDING_TRANSFER_OUT"},
+				})
+				if wantErr := actionStatus == godaddy.ActionStatusFailed; diags.HasError() != wantErr {
+					t.Fatalf("handleOutboundTransfer() with a %s action: diagnostics = %v", actionStatus, diags)
+				}
+				if !diags.HasError() && model.OutboundTransferStatus.ValueString() != tt.wantStatus {
+					t.Errorf("outbound_transfer_status = %s, want %s", model.OutboundTransferStatus, tt.wantStatus)
+				}
+			}
+		})
+	}
+}
+
+func TestDomainResource_PlanPastRenewalDeadline(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	expiry := newDomainExpiryChecker(30)
+	expiry.now = func() time.Time { return now }
+	r := &DomainResource{
+		client:          newTestClient("http://127.0.0.1:0"),
+		contactProfiles: map[string]ContactProfile{},
+		expiry:          expiry,
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"domain":                   tftypes.NewValue(tftypes.String, "example.com"),
+		"renew_auto":               tftypes.NewValue(tftypes.Bool, false),
+		"renew_deadline":           tftypes.NewValue(tftypes.String, "2026-09-01T00:00:00Z"),
+		"outbound_transfer_policy": tftypes.NewValue(tftypes.String, outboundTransferManual),
+	})
+
+	planned := planResource(t, r, state, map[string]tftypes.Value{
+		"domain":                   tftypes.NewValue(tftypes.String, "example.com"),
+		"renew_auto":               tftypes.NewValue(tftypes.Bool, false),
+		"outbound_transfer_policy": tftypes.NewValue(tftypes.String, outboundTransferManual),
+	})
+	if !planned.Diagnostics.HasError() {
+		t.Fatal("ModifyPlan() expected an error for a configured domain past its renewal deadline")
+	}
+	if detail := planned.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "terraform state rm") {
+		t.Errorf("ModifyPlan() error = %s, want working remediation", detail)
+	}
+
+	// Destroying the domain, or removing it from the configuration, plans a
+	// null value and must not fail
+	s := resourceSchema(r)
+	null := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+	destroy := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: s, Raw: null}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: null},
+		Plan:   tfsdk.Plan{Schema: s, Raw: null},
+		State:  state,
+	}, destroy)
+	if destroy.Diagnostics.HasError() {
+		t.Errorf("ModifyPlan() diagnostics on destroy = %v", destroy.Diagnostics)
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainSuggestionsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DomainSuggestionsDataSource{}
+
+func NewDomainSuggestionsDataSource() datasource.DataSource {
+	return &DomainSuggestionsDataSource{}
+}
+
+type DomainSuggestionsDataSource struct {
+	client *godaddy.Client
+}
+
+type DomainSuggestionsDataSourceModel struct {
+	Query     types.String `tfsdk:"query"`
+	Country   types.String `tfsdk:"country"`
+	City      types.String `tfsdk:"city"`
+	Sources   types.Set    `tfsdk:"sources"`
+	TLDs      types.Set    `tfsdk:"tlds"`
+	LengthMin types.Int32  `tfsdk:"length_min"`
+	LengthMax types.Int32  `tfsdk:"length_max"`
+	Limit     types.Int32  `tfsdk:"limit"`
+	WaitMs    types.Int32  `tfsdk:"wait_ms"`
+	Domains   types.List   `tfsdk:"domains"`
+}
+
+func (d *DomainSuggestionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_suggestions"
+}
+
+func (d *DomainSuggestionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for domain names suggested by GoDaddy for a keyword or domain.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A domain name or keywords to base the suggestions on.",
+				Required:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Two-letter country code used to suggest country and city specific names.",
+				Optional:            true,
+				Validators: []validator.String{
+					CountryCodeValidator(),
+				},
+			},
+			"city": schema.StringAttribute{
+				MarkdownDescription: "City name used to suggest city specific names.",
+				Optional:            true,
+			},
+			"sources": schema.SetAttribute{
+				MarkdownDescription: "Sources of suggestions: `CC_TLD`, `EXTENSION`, `KEYWORD_SPIN` or `PREMIUM`. Defaults to all sources.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					SetOfStringOneOfValidator(godaddy.ValidSuggestionSources()...),
+				},
+			},
+			"tlds": schema.SetAttribute{
+				MarkdownDescription: "Only suggest domains with these TLDs (e.g. `com`, `shop`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"length_min": schema.Int32Attribute{
+				MarkdownDescription: "Minimum length of the second-level name.",
+				Optional:            true,
+			},
+			"length_max": schema.Int32Attribute{
+				MarkdownDescription: "Maximum length of the second-level name.",
+				Optional:            true,
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of suggestions to return.",
+				Optional:            true,
+			},
+			"wait_ms": schema.Int32Attribute{
+				MarkdownDescription: "How long GoDaddy may spend collecting suggestions, in milliseconds. " +
+					"Suggestions from slower sources are left out once it is reached.",
+				Optional: true,
+			},
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "The suggested domain names, ready to use as `domains` of `godaddy_domain_availability`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DomainSuggestionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data DomainSuggestionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.Int32{
+		"length_min": data.LengthMin,
+		"length_max": data.LengthMax,
+		"limit":      data.Limit,
+		"wait_ms":    data.WaitMs,
+	} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt32() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Value",
+				fmt.Sprintf("%s must be at least 1, got %d.", name, value.ValueInt32()),
+			)
+		}
+	}
+
+	if data.LengthMin.IsNull() || data.LengthMin.IsUnknown() || data.LengthMax.IsNull() || data.LengthMax.IsUnknown() {
+		return
+	}
+	if data.LengthMin.ValueInt32() > data.LengthMax.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length_min"),
+			"Invalid Length Bounds",
+			fmt.Sprintf("length_min (%d) can't be greater than length_max (%d).", data.LengthMin.ValueInt32(), data.LengthMax.ValueInt32()),
+		)
+	}
+}
+
+func (d *DomainSuggestionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainSuggestionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainSuggestionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := godaddy.SuggestionOptions{
+		Country:   data.Country.ValueString(),
+		City:      data.City.ValueString(),
+		LengthMin: int(data.LengthMin.ValueInt32()),
+		LengthMax: int(data.LengthMax.ValueInt32()),
+		Limit:     int(data.Limit.ValueInt32()),
+		WaitMs:    int(data.WaitMs.ValueInt32()),
+	}
+	if !data.Sources.IsNull() {
+		resp.Diagnostics.Append(data.Sources.ElementsAs(ctx, &opts.Sources, false)...)
+	}
+	if !data.TLDs.IsNull() {
+		resp.Diagnostics.Append(data.TLDs.ElementsAs(ctx, &opts.TLDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := data.Query.ValueString()
+	suggestions, err := d.client.SuggestDomains(ctx, query, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Suggestions",
+			fmt.Sprintf("Could not get domain suggestions for %q: %s", query, err),
+		)
+		return
+	}
+
+	domains := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		domains[i] = suggestion.Domain
+	}
+
+	domainsList, diags := types.ListValueFrom(ctx, types.StringType, domains)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Domains = domainsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainTransferInResource{}
+var _ resource.ResourceWithValidateConfig = &DomainTransferInResource{}
+
+// transferPollInterval is how often a transfer is checked while waiting
+const transferPollInterval = time.Minute
+
+// transferStatusCompleted is the status reported once the transferred domain
+// is ACTIVE in the account
+const transferStatusCompleted = "COMPLETED"
+
+func NewDomainTransferInResource() resource.Resource {
+	return &DomainTransferInResource{}
+}
+
+type DomainTransferInResource struct {
+	client *godaddy.Client
+
+	// pollInterval overrides transferPollInterval when set
+	pollInterval time.Duration
+}
+
+type DomainTransferInResourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Domain            types.String  `tfsdk:"domain"`
+	AuthCode          types.String  `tfsdk:"auth_code"`
+	AgreedBy          types.String  `tfsdk:"agreed_by"`
+	AgreementKeys     types.List    `tfsdk:"agreement_keys"`
+	Price             types.Float64 `tfsdk:"price"`
+	Currency          types.String  `tfsdk:"currency"`
+	Period            types.Int32   `tfsdk:"period"`
+	Privacy           types.Bool    `tfsdk:"privacy"`
+	RenewAuto         types.Bool    `tfsdk:"renew_auto"`
+	WaitForCompletion types.Bool    `tfsdk:"wait_for_completion"`
+	WaitTimeout       types.String  `tfsdk:"wait_timeout"`
+	Status            types.String  `tfsdk:"status"`
+}
+
+func (r *DomainTransferInResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_transfer_in"
+}
+
+func (r *DomainTransferInResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Transfers a domain from another registrar into the GoDaddy account. " +
+			"Requires the provider `customer_id` and Terraform 1.11 or later for the write-only `auth_code`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to transfer in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_code": schema.StringAttribute{
+				MarkdownDescription: "Authorization (EPP) code from the losing registrar. Write-only: it is never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"agreed_by": schema.StringAttribute{
+				MarkdownDescription: "Who agrees to the transfer agreements, usually the IP address of the person applying it.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agreement_keys": schema.ListAttribute{
+				MarkdownDescription: "Keys of the agreements being accepted.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"price": schema.Float64Attribute{
+				MarkdownDescription: "Transfer price being agreed to, in currency units (e.g. 11.99).",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Currency of `price` (e.g. USD).",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"period": schema.Int32Attribute{
+				MarkdownDescription: "Number of years added to the registration by the transfer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(1),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"privacy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable WHOIS privacy once the domain is transferred.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"renew_auto": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transferred domain auto-renews.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait until the domain is ACTIVE in the account before finishing the apply.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the transfer to complete, as a Go duration (e.g. `336h`). Defaults to 7 days.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("168h"),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Transfer status reported by GoDaddy, or `COMPLETED` once the domain is ACTIVE in the account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainTransferInResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainTransferInResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WaitTimeout.IsNull() && !data.WaitTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_timeout"),
+				"Invalid Wait Timeout",
+				fmt.Sprintf("Could not parse %q as a duration: %s", data.WaitTimeout.ValueString(), err),
+			)
+		}
+	}
+
+	if !data.Price.IsNull() && data.Currency.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("currency"),
+			"Missing Currency",
+			"currency must be set when price is set.",
+		)
+	}
+}
+
+func (r *DomainTransferInResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DomainTransferInResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainTransferInResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	var authCode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_code"), &authCode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var agreementKeys []string
+	resp.Diagnostics.Append(data.AgreementKeys.ElementsAs(ctx, &agreementKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transfer := godaddy.DomainTransferIn{
+		AuthCode: authCode.ValueString(),
+		Consent: godaddy.TransferConsent{
+			AgreedAt:      time.Now().UTC().Format(time.RFC3339),
+			AgreedBy:      data.AgreedBy.ValueString(),
+			AgreementKeys: agreementKeys,
+			Currency:      data.Currency.ValueString(),
+		},
+		Period:    int(data.Period.ValueInt32()),
+		Privacy:   data.Privacy.ValueBool(),
+		RenewAuto: data.RenewAuto.ValueBool(),
+	}
+	if !data.Price.IsNull() {
+		transfer.Consent.Price = godaddy.ToMicros(data.Price.ValueFloat64())
+	}
+
+	domain := data.Domain.ValueString()
+	if err := r.client.StartDomainTransferIn(ctx, domain, transfer); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Domain Transfer",
+			fmt.Sprintf("Could not start transfer of domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(domain)
+	data.Status = types.StringValue("PENDING")
+
+	// Save the state now so a failed wait doesn't lose track of the transfer
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(r.waitForCompletion(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if status, found, err := r.transferStatus(ctx, domain); err == nil && found {
+		data.Status = types.StringValue(status)
+	}
+
+	tflog.Trace(ctx, "created domain transfer in resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferInResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainTransferInResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, found, err := r.transferStatus(ctx, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Transfer",
+			fmt.Sprintf("Could not read transfer status of domain %s: %s", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Status = types.StringValue(status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferInResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DomainTransferInResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the wait settings can change in place
+	data.Status = state.Status
+
+	if data.WaitForCompletion.ValueBool() && state.Status.ValueString() != transferStatusCompleted {
+		resp.Diagnostics.Append(r.waitForCompletion(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferInResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainTransferInResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	status, found, err := r.transferStatus(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Transfer",
+			fmt.Sprintf("Could not read transfer status of domain %s: %s", domain, err),
+		)
+		return
+	}
+
+	if !found || status == transferStatusCompleted {
+		// Note: a completed transfer can't be undone; the domain stays in the account
+		tflog.Warn(ctx, "Domain transfer removed from Terraform state; the domain remains in the GoDaddy account",
+			map[string]interface{}{"domain": domain})
+		return
+	}
+	if (godaddy.DomainTransferStatus{Status: status}).IsFailed() {
+		// GoDaddy refuses to cancel a transfer that has already ended
+		tflog.Warn(ctx, "Domain transfer removed from Terraform state; it had already ended",
+			map[string]interface{}{"domain": domain, "status": status})
+		return
+	}
+
+	if err := r.client.CancelDomainTransferIn(ctx, domain); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Cancelling Domain Transfer",
+			fmt.Sprintf("Could not cancel transfer of domain %s: %s", domain, err),
+		)
+	}
+}
+
+// transferStatus returns the transfer status of the domain. Once GoDaddy no
+// longer reports a transfer, the domain is looked up in the account instead;
+// found is false when it isn't there either.
+func (r *DomainTransferInResource) transferStatus(ctx context.Context, domain string) (status string, found bool, err error) {
+	transfer, err := r.client.GetDomainTransferStatus(ctx, domain)
+	if err == nil {
+		return transfer.Status, true, nil
+	}
+	if !strings.Contains(err.Error(), "404") {
+		return "", false, err
+	}
+
+	domains, err := r.client.ListDomains(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, d := range domains {
+		if strings.EqualFold(d.Domain, domain) {
+			if d.Status == "ACTIVE" {
+				return transferStatusCompleted, true, nil
+			}
+			return d.Status, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// waitForCompletion waits for the transfer of data.Domain and records the
+// outcome in data.Status. Running out of time is only a warning: the transfer
+// carries on at GoDaddy, and failing the apply would taint the resource so the
+// next apply cancels the transfer and starts over.
+func (r *DomainTransferInResource) waitForCompletion(ctx context.Context, data *DomainTransferInResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	domain := data.Domain.ValueString()
+
+	err := r.waitForActive(ctx, domain, data.WaitTimeout.ValueString())
+	switch {
+	case err == nil:
+		data.Status = types.StringValue(transferStatusCompleted)
+	case errors.Is(err, godaddy.ErrWaitTimeout):
+		diags.AddWarning(
+			"Domain Transfer Still Pending",
+			fmt.Sprintf("Transfer of domain %s did not complete within %s. It continues at GoDaddy, "+
+				"and its status is refreshed on the next plan.", domain, data.WaitTimeout.ValueString()),
+		)
+		if status, found, err := r.transferStatus(ctx, domain); err == nil && found {
+			data.Status = types.StringValue(status)
+		}
+	default:
+		diags.AddError(
+			"Error Waiting for Domain Transfer",
+			fmt.Sprintf("Transfer of domain %s did not complete: %s", domain, err),
+		)
+	}
+
+	return diags
+}
+
+// waitForActive polls until the domain is ACTIVE in the account, the transfer
+// fails, or the timeout expires.
+func (r *DomainTransferInResource) waitForActive(ctx context.Context, domain, timeout string) error {
+	wait, err := time.ParseDuration(timeout)
+	if err != nil {
+		return fmt.Errorf("invalid wait_timeout: %w", err)
+	}
+
+	interval := transferPollInterval
+	if r.pollInterval > 0 {
+		interval = r.pollInterval
+	}
+
+	return godaddy.WaitFor(ctx, godaddy.WaitOptions{Timeout: wait, Interval: interval}, func(ctx context.Context) (bool, error) {
+		domains, err := r.client.ListDomains(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, d := range domains {
+			if strings.EqualFold(d.Domain, domain) && d.Status == "ACTIVE" {
+				return true, nil
+			}
+		}
+
+		transfer, err := r.client.GetDomainTransferStatus(ctx, domain)
+		if err == nil && transfer.IsFailed() {
+			return false, fmt.Errorf("transfer ended with status %s", transfer.Status)
+		}
+
+		tflog.Debug(ctx, "Waiting for domain transfer to complete", map[string]interface{}{
+			"domain": domain,
+		})
+		return false, nil
+	})
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeTransferServer serves a transfer of example.com. An empty transfer
+// status means GoDaddy no longer reports the transfer; an empty domain status
+// means the domain is not in the account.
+type fakeTransferServer struct {
+	transferStatus string
+	domainStatus   string
+	started        bool
+	cancelled      bool
+}
+
+func (f *fakeTransferServer) start(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/customers/cust-1/domains/example.com/transfer":
+			f.started = true
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/customers/cust-1/domains/example.com/transfer":
+			if f.transferStatus == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"domain":"example.com","status":"` + f.transferStatus + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/customers/cust-1/domains/example.com/transferInCancel":
+			f.cancelled = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/domains":
+			if f.domainStatus == "" {
+				w.Write([]byte(`[]`))
+				return
+			}
+			w.Write([]byte(`[{"domain":"example.com","status":"` + f.domainStatus + `"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func transferInConfig(waitForCompletion bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"domain":    tftypes.NewValue(tftypes.String, "example.com"),
+		"auth_code": tftypes.NewValue(tftypes.String, "secret-code"),
+		"agreed_by": tftypes.NewValue(tftypes.String, "203.0.113.10"),
+		"agreement_keys": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "DNTA"),
+		}),
+		"period":              tftypes.NewValue(tftypes.Number, 1),
+		"privacy":             tftypes.NewValue(tftypes.Bool, false),
+		"renew_auto":          tftypes.NewValue(tftypes.Bool, true),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, waitForCompletion),
+		"wait_timeout":        tftypes.NewValue(tftypes.String, "1m"),
+	}
+}
+
+func TestDomainTransferInResource_Create(t *testing.T) {
+	tests := []struct {
+		name              string
+		waitForCompletion bool
+		transferStatus    string
+		domainStatus      string
+		wantStatus        string
+	}{
+		{name: "pending", transferStatus: "PENDING_ADMIN_APPROVAL", wantStatus: "PENDING_ADMIN_APPROVAL"},
+		{name: "wait for completion", waitForCompletion: true, transferStatus: "COMPLETED_SUCCESSFULLY",
+			domainStatus: "ACTIVE", wantStatus: transferStatusCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeTransferServer{transferStatus: tt.transferStatus, domainStatus: tt.domainStatus}
+			r := &DomainTransferInResource{client: newTestClient(fake.start(t).URL)}
+
+			resp := createResource(t, r, transferInConfig(tt.waitForCompletion))
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+			}
+			if !fake.started {
+				t.Fatal("Create() did not start the transfer")
+			}
+
+			var data DomainTransferInResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if data.Status.ValueString() != tt.wantStatus {
+				t.Errorf("status = %s, want %s", data.Status, tt.wantStatus)
+			}
+			if !data.AuthCode.IsNull() {
+				t.Error("auth_code was stored in the state")
+			}
+		})
+	}
+}
+
+func TestDomainTransferInResource_CreateWaitFailed(t *testing.T) {
+	fake := &fakeTransferServer{transferStatus: "FAILED_ADMIN_DENIED"}
+	r := &DomainTransferInResource{client: newTestClient(fake.start(t).URL)}
+
+	resp := createResource(t, r, transferInConfig(true))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Create() expected an error for a failed transfer")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "FAILED_ADMIN_DENIED") {
+		t.Errorf("Create() error = %s, want the transfer status", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	// The transfer is still tracked, so it can be destroyed
+	var data DomainTransferInResourceModel
+	resp.State.Get(context.Background(), &data)
+	if data.ID.ValueString() != "example.com" {
+		t.Errorf("id = %s, want the transfer to stay in the state", data.ID)
+	}
+}
+
+func TestDomainTransferInResource_WaitTimeout(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeTransferServer{transferStatus: "PENDING_ADMIN_APPROVAL"}
+	r := &DomainTransferInResource{client: newTestClient(fake.start(t).URL), pollInterval: time.Millisecond}
+
+	config := transferInConfig(true)
+	config["wait_timeout"] = tftypes.NewValue(tftypes.String, "10ms")
+
+	checkPending := func(t *testing.T, diags diag.Diagnostics, state tfsdk.State) {
+		t.Helper()
+		if diags.HasError() {
+			t.Fatalf("diagnostics = %v, want a timeout to be a warning so the resource isn't tainted", diags)
+		}
+		if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != "Domain Transfer Still Pending" {
+			t.Errorf("warnings = %v, want the pending transfer warning", diags.Warnings())
+		}
+
+		var data DomainTransferInResourceModel
+		state.Get(ctx, &data)
+		if data.Status.ValueString() != "PENDING_ADMIN_APPROVAL" {
+			t.Errorf("status = %s, want the pending transfer status", data.Status)
+		}
+	}
+
+	t.Run("create", func(t *testing.T) {
+		resp := createResource(t, r, config)
+		checkPending(t, resp.Diagnostics, resp.State)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		state := newResourceState(t, r, map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "example.com"),
+			"domain":              tftypes.NewValue(tftypes.String, "example.com"),
+			"agreed_by":           config["agreed_by"],
+			"agreement_keys":      config["agreement_keys"],
+			"period":              config["period"],
+			"privacy":             config["privacy"],
+			"renew_auto":          config["renew_auto"],
+			"wait_for_completion": tftypes.NewValue(tftypes.Bool, false),
+			"wait_timeout":        tftypes.NewValue(tftypes.String, "168h"),
+			"status":              tftypes.NewValue(tftypes.String, "PENDING_ADMIN_APPROVAL"),
+		})
+		planned := planResource(t, r, state, config)
+
+		resp := &resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: planned.Plan, State: state}, resp)
+		checkPending(t, resp.Diagnostics, resp.State)
+	})
+
+	if fake.cancelled {
+		t.Error("the pending transfer was cancelled")
+	}
+}
+
+func TestDomainTransferInResource_ReadDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		transferStatus string
+		domainStatus   string
+		wantStatus     string
+		wantRemoved    bool
+		wantCancel     bool
+	}{
+		{name: "pending", transferStatus: "PENDING_ADMIN_APPROVAL", wantStatus: "PENDING_ADMIN_APPROVAL", wantCancel: true},
+		{name: "failed", transferStatus: "FAILED_ADMIN_DENIED", wantStatus: "FAILED_ADMIN_DENIED"},
+		{name: "cancelled", transferStatus: "CANCELLED", wantStatus: "CANCELLED"},
+		{name: "completed", domainStatus: "ACTIVE", wantStatus: transferStatusCompleted},
+		{name: "gone", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			fake := &fakeTransferServer{transferStatus: tt.transferStatus, domainStatus: tt.domainStatus}
+			r := &DomainTransferInResource{client: newTestClient(fake.start(t).URL)}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "example.com"),
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+				"status": tftypes.NewValue(tftypes.String, "PENDING"),
+			})
+
+			read := readResource(t, r, state)
+			if read.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", read.Diagnostics)
+			}
+			if tt.wantRemoved {
+				if !read.State.Raw.IsNull() {
+					t.Error("Read() kept a transfer GoDaddy no longer knows about")
+				}
+			} else {
+				var data DomainTransferInResourceModel
+				read.Diagnostics.Append(read.State.Get(ctx, &data)...)
+				if data.Status.ValueString() != tt.wantStatus {
+					t.Errorf("status = %s, want %s", data.Status, tt.wantStatus)
+				}
+			}
+
+			deleted := deleteResource(t, r, state)
+			if deleted.Diagnostics.HasError() {
+				t.Fatalf("Delete() diagnostics = %v", deleted.Diagnostics)
+			}
+			if fake.cancelled != tt.wantCancel {
+				t.Errorf("cancelled = %v, want %v", fake.cancelled, tt.wantCancel)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DomainsDataSource{}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+type DomainsDataSource struct {
+	client *godaddy.Client
+}
+
+type DomainsDataSourceModel struct {
+	Statuses           types.Set    `tfsdk:"statuses"`
+	StatusGroups       types.Set    `tfsdk:"status_groups"`
+	TLDs               types.Set    `tfsdk:"tlds"`
+	NameRegex          types.String `tfsdk:"name_regex"`
+	ExpiresAfter       types.String `tfsdk:"expires_after"`
+	ExpiresBefore      types.String `tfsdk:"expires_before"`
+	IncludeNameservers types.Bool   `tfsdk:"include_nameservers"`
+	Names              types.List   `tfsdk:"names"`
+	Domains            types.List   `tfsdk:"domains"`
+}
+
+type DomainSummaryModel struct {
+	Domain              types.String `tfsdk:"domain"`
+	DomainID            types.Int64  `tfsdk:"domain_id"`
+	Status              types.String `tfsdk:"status"`
+	Expires             types.String `tfsdk:"expires"`
+	RenewDeadline       types.String `tfsdk:"renew_deadline"`
+	ExpirationProtected types.Bool   `tfsdk:"expiration_protected"`
+	Locked              types.Bool   `tfsdk:"locked"`
+	Privacy             types.Bool   `tfsdk:"privacy"`
+	RenewAuto           types.Bool   `tfsdk:"renew_auto"`
+	TransferProtected   types.Bool   `tfsdk:"transfer_protected"`
+	Nameservers         types.List   `tfsdk:"nameservers"`
+}
+
+func (d *DomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for listing the domains in the GoDaddy account.",
+		Attributes: map[string]schema.Attribute{
+			"statuses": schema.SetAttribute{
+				MarkdownDescription: "Only include domains with one of these statuses (e.g. `ACTIVE`, `PENDING_TRANSFER`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status_groups": schema.SetAttribute{
+				MarkdownDescription: "Only include domains in one of these status groups: `INACTIVE`, `PRE_REGISTRATION`, " +
+					"`REDEMPTION`, `RENEWABLE`, `VERIFICATION_ICANN` or `VISIBLE`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					SetOfStringOneOfValidator(godaddy.ValidStatusGroups()...),
+				},
+			},
+			"tlds": schema.SetAttribute{
+				MarkdownDescription: "Only include domains with one of these TLDs (e.g. `com`, `co.uk`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include domains whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"expires_after": schema.StringAttribute{
+				MarkdownDescription: "Only include domains expiring at or after this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"expires_before": schema.StringAttribute{
+				MarkdownDescription: "Only include domains expiring before this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"include_nameservers": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return the nameservers of each domain. Defaults to `false`.",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the matching domains, sorted alphabetically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching domains, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain name.",
+							Computed:            true,
+						},
+						"domain_id": schema.Int64Attribute{
+							MarkdownDescription: "GoDaddy's ID of the domain.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the domain.",
+							Computed:            true,
+						},
+						"expires": schema.StringAttribute{
+							MarkdownDescription: "The expiration date of the domain.",
+							Computed:            true,
+						},
+						"renew_deadline": schema.StringAttribute{
+							MarkdownDescription: "The last date the domain can be renewed before it is lost.",
+							Computed:            true,
+						},
+						"expiration_protected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain is protected from expiration.",
+							Computed:            true,
+						},
+						"locked": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain is locked to prevent transfers.",
+							Computed:            true,
+						},
+						"privacy": schema.BoolAttribute{
+							MarkdownDescription: "Whether WHOIS privacy is enabled.",
+							Computed:            true,
+						},
+						"renew_auto": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain is set to auto-renew.",
+							Computed:            true,
+						},
+						"transfer_protected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain is protected from transfers.",
+							Computed:            true,
+						},
+						"nameservers": schema.ListAttribute{
+							MarkdownDescription: "The nameservers of the domain, when `include_nameservers` is set.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data DomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Could not compile %q: %s", data.NameRegex.ValueString(), err),
+			)
+		}
+	}
+
+	for name, value := range map[string]types.String{
+		"expires_after":  data.ExpiresAfter,
+		"expires_before": data.ExpiresBefore,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp (e.g. 2026-12-31T23:59:59Z): %s", name, err),
+			)
+		}
+	}
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := godaddy.DomainListOptions{IncludeNameservers: data.IncludeNameservers.ValueBool()}
+	var tlds []string
+	if !data.Statuses.IsNull() {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &opts.Statuses, false)...)
+	}
+	if !data.StatusGroups.IsNull() {
+		resp.Diagnostics.Append(data.StatusGroups.ElementsAs(ctx, &opts.StatusGroups, false)...)
+	}
+	if !data.TLDs.IsNull() {
+		resp.Diagnostics.Append(data.TLDs.ElementsAs(ctx, &tlds, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := d.client.ListDomainsWithOptions(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Domains",
+			fmt.Sprintf("Could not list domains: %s", err),
+		)
+		return
+	}
+
+	// The filters were validated with the configuration, but may have been
+	// unknown at that point
+	filter := domainFilter{tlds: tlds}
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+	if !data.ExpiresAfter.IsNull() {
+		after, err := time.Parse(time.RFC3339, data.ExpiresAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_after"), "Invalid Timestamp", err.Error())
+			return
+		}
+		filter.expiresAfter = &after
+	}
+	if !data.ExpiresBefore.IsNull() {
+		before, err := time.Parse(time.RFC3339, data.ExpiresBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_before"), "Invalid Timestamp", err.Error())
+			return
+		}
+		filter.expiresBefore = &before
+	}
+
+	matched := make([]godaddy.DomainDetail, 0, len(domains))
+	for _, domain := range domains {
+		if filter.matches(domain.Domain) {
+			matched = append(matched, domain)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Domain.Domain < matched[j].Domain.Domain
+	})
+
+	names := make([]string, len(matched))
+	summaries := make([]DomainSummaryModel, len(matched))
+	for i, domain := range matched {
+		names[i] = domain.Domain.Domain
+		summaries[i] = DomainSummaryModel{
+			Domain:              types.StringValue(domain.Domain.Domain),
+			DomainID:            types.Int64Value(int64(domain.DomainID)),
+			Status:              types.StringValue(domain.Status),
+			Expires:             optionalTime(domain.Expires),
+			RenewDeadline:       optionalTime(domain.RenewDeadline),
+			ExpirationProtected: types.BoolValue(domain.ExpirationProtected),
+			Locked:              types.BoolValue(domain.Locked),
+			Privacy:             types.BoolValue(domain.Privacy),
+			RenewAuto:           types.BoolValue(domain.RenewAuto),
+			TransferProtected:   types.BoolValue(domain.TransferProtected),
+			Nameservers:         types.ListNull(types.StringType),
+		}
+
+		if opts.IncludeNameservers {
+			nameservers, diags := types.ListValueFrom(ctx, types.StringType, domain.Nameservers)
+			resp.Diagnostics.Append(diags...)
+			summaries[i].Nameservers = nameservers
+		}
+	}
+
+	namesList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	domainsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainSummaryAttributeTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Names = namesList
+	data.Domains = domainsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// domainFilter holds the filters that GoDaddy can't apply itself
+type domainFilter struct {
+	tlds          []string
+	nameRegex     *regexp.Regexp
+	expiresAfter  *time.Time
+	expiresBefore *time.Time
+}
+
+func (f domainFilter) matches(domain godaddy.Domain) bool {
+	name := strings.ToLower(domain.Domain)
+
+	if len(f.tlds) > 0 {
+		found := false
+		for _, tld := range f.tlds {
+			if strings.HasSuffix(name, "."+strings.ToLower(strings.TrimPrefix(tld, "."))) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.nameRegex != nil && !f.nameRegex.MatchString(domain.Domain) {
+		return false
+	}
+
+	if f.expiresAfter != nil || f.expiresBefore != nil {
+		if domain.Expires == nil {
+			return false
+		}
+		if f.expiresAfter != nil && domain.Expires.Before(*f.expiresAfter) {
+			return false
+		}
+		if f.expiresBefore != nil && !domain.Expires.Before(*f.expiresBefore) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func domainSummaryAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain":               types.StringType,
+		"domain_id":            types.Int64Type,
+		"status":               types.StringType,
+		"expires":              types.StringType,
+		"renew_deadline":       types.StringType,
+		"expiration_protected": types.BoolType,
+		"locked":               types.BoolType,
+		"privacy":              types.BoolType,
+		"renew_auto":           types.BoolType,
+		"transfer_protected":   types.BoolType,
+		"nameservers":          types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+)
+
+func TestDomainFilter_Matches(t *testing.T) {
+	expires := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	early := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter domainFilter
+		domain godaddy.Domain
+		want   bool
+	}{
+		{
+			name:   "no filters",
+			domain: godaddy.Domain{Domain: "example.com"},
+			want:   true,
+		},
+		{
+			name:   "matching tld",
+			filter: domainFilter{tlds: []string{"net", ".co.uk"}},
+			domain: godaddy.Domain{Domain: "Example.CO.UK"},
+			want:   true,
+		},
+		{
+			name:   "tld must match a whole label",
+			filter: domainFilter{tlds: []string{"uk"}},
+			domain: godaddy.Domain{Domain: "example.co.uk.example"},
+			want:   false,
+		},
+		{
+			name:   "name regex",
+			filter: domainFilter{nameRegex: regexp.MustCompile(`^shop-`)},
+			domain: godaddy.Domain{Domain: "blog-example.com"},
+			want:   false,
+		},
+		{
+			name:   "inside expiry window",
+			filter: domainFilter{expiresAfter: &after, expiresBefore: &before},
+			domain: godaddy.Domain{Domain: "example.com", Expires: &expires},
+			want:   true,
+		},
+		{
+			name:   "after expiry window",
+			filter: domainFilter{expiresBefore: &early},
+			domain: godaddy.Domain{Domain: "example.com", Expires: &early},
+			want:   false,
+		},
+		{
+			name:   "unknown expiry with window",
+			filter: domainFilter{expiresAfter: &after},
+			domain: godaddy.Domain{Domain: "example.com"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tt.domain); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestClient returns a client for a fake GoDaddy API served at serverURL.
+func newTestClient(serverURL string) *godaddy.Client {
+	return godaddy.NewClient("test-key", "test-secret",
+		godaddy.WithBaseURL(serverURL), godaddy.WithCustomerID("cust-1"))
+}
+
+// newTestObject builds a value of schemaType from values, leaving every
+// attribute that is not in values null.
+func newTestObject(t *testing.T, schemaType attr.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := schemaType.TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type %s is not an object", schemaType)
+	}
+
+	all := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		all[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		if _, ok := all[name]; !ok {
+			t.Fatalf("schema has no attribute %q", name)
+		}
+		all[name] = value
+	}
+
+	return tftypes.NewValue(objectType, all)
+}
+
+// readDataSource runs d.Read with a configuration built from values.
+func readDataSource(t *testing.T, d datasource.DataSource, values map[string]tftypes.Value) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newTestObject(t, schemaResp.Schema.Type(), values)}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	return resp
+}
+
+// openEphemeralResource runs r.Open with a configuration built from values.
+func openEphemeralResource(t *testing.T, r ephemeral.EphemeralResource, values map[string]tftypes.Value) *ephemeral.OpenResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newTestObject(t, schemaResp.Schema.Type(), values)}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+	r.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+	return resp
+}
+
+// testProvider is the provider with its resources configured to use client.
+type testProvider struct {
+	*GoDaddyProvider
+	client *godaddy.Client
+}
+
+func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	providerData := &GoDaddyProviderData{Client: p.client}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ActionData = providerData
+}
+
+// newTestProviderServer returns a configured protocol server for the
+// provider, using a client for a fake GoDaddy API served at serverURL. Unlike
+// the helpers that call a resource directly, it lets the framework carry
+// private data from one call to the next.
+func newTestProviderServer(t *testing.T, serverURL string) tfprotov6.ProviderServer {
+	t.Helper()
+	ctx := context.Background()
+
+	p := &testProvider{GoDaddyProvider: &GoDaddyProvider{version: "test"}, client: newTestClient(serverURL)}
+	server := providerserver.NewProtocol6(p)()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	config, err := tfprotov6.NewDynamicValue(schemaResp.Schema.Type().TerraformType(ctx), newTestObject(t, schemaResp.Schema.Type(), nil))
+	if err != nil {
+		t.Fatalf("could not encode provider config: %s", err)
+	}
+
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider() = %v, %v", resp, err)
+	}
+
+	return server
+}
+
+// invokeAction runs a.Invoke with a configuration built from values and
+// returns the response with the progress messages that were sent.
+func invokeAction(t *testing.T, a action.Action, values map[string]tftypes.Value) (*action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newTestObject(t, schemaResp.Schema.Type(), values)}
+
+	var messages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, resp)
+
+	return resp, messages
+}
+
+// resourceSchema returns the schema of r.
+func resourceSchema(r resource.Resource) schema.Schema {
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
+
+// newResourceState builds a state of r from values.
+func newResourceState(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	s := resourceSchema(r)
+	return tfsdk.State{Schema: s, Raw: newTestObject(t, s.Type(), values)}
+}
+
+// planResource builds the plan Terraform proposes for config on top of
+// state, a null state meaning a create, and runs r.ModifyPlan on it when r
+// implements it. Like Terraform, computed attributes left out of config keep
+// their state value when nothing else changes and are unknown otherwise, and
+// write-only attributes are null. Schema plan modifiers are not run.
+func planResource(t *testing.T, r resource.Resource, state tfsdk.State, config map[string]tftypes.Value) *resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	s := resourceSchema(r)
+
+	if state.Raw.IsNull() {
+		state = tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	}
+	prior := map[string]tftypes.Value{}
+	if !state.Raw.IsNull() {
+		if err := state.Raw.As(&prior); err != nil {
+			t.Fatalf("could not read state: %s", err)
+		}
+	}
+
+	configRaw := newTestObject(t, s.Type(), config)
+	// As shares the map of the value, so the plan gets a copy of it
+	configValues := map[string]tftypes.Value{}
+	if err := configRaw.As(&configValues); err != nil {
+		t.Fatalf("could not read config: %s", err)
+	}
+	proposed := make(map[string]tftypes.Value, len(configValues))
+	for name, value := range configValues {
+		proposed[name] = value
+	}
+	for name, attribute := range s.Attributes {
+		if attribute.IsWriteOnly() {
+			proposed[name] = tftypes.NewValue(proposed[name].Type(), nil)
+			continue
+		}
+		if !attribute.IsComputed() || !proposed[name].IsNull() {
+			continue
+		}
+		if value, ok := prior[name]; ok {
+			proposed[name] = value
+		} else {
+			proposed[name] = tftypes.NewValue(proposed[name].Type(), tftypes.UnknownValue)
+		}
+	}
+
+	// An update leaves computed attributes that are not configured unknown
+	if !state.Raw.IsNull() && !tftypes.NewValue(configRaw.Type(), proposed).Equal(state.Raw) {
+		for name, attribute := range s.Attributes {
+			if attribute.IsComputed() && configValues[name].IsNull() {
+				proposed[name] = tftypes.NewValue(proposed[name].Type(), tftypes.UnknownValue)
+			}
+		}
+	}
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(configRaw.Type(), proposed)}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	if modifier, ok := r.(resource.ResourceWithModifyPlan); ok {
+		modifier.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: s, Raw: configRaw},
+			Plan:   plan,
+			State:  state,
+		}, resp)
+	}
+
+	return resp
+}
+
+// createResource plans config with planResource and runs r.Create with the
+// resulting plan.
+func createResource(t *testing.T, r resource.Resource, config map[string]tftypes.Value) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	planned := planResource(t, r, tfsdk.State{}, config)
+	if planned.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() diagnostics = %v", planned.Diagnostics)
+	}
+
+	plan := planned.Plan
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: newTestObject(t, plan.Schema.Type(), config)},
+		Plan:   plan,
+	}, resp)
+	return resp
+}
+
+// readResource runs r.Read with state.
+func readResource(t *testing.T, r resource.Resource, state tfsdk.State) *resource.ReadResponse {
+	t.Helper()
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+// deleteResource runs r.Delete with state.
+func deleteResource(t *testing.T, r resource.Resource, state tfsdk.State) *resource.DeleteResponse {
+	t.Helper()
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+	return resp
+}
+
+// importResource runs r.ImportState for id.
+func importResource(t *testing.T, r resource.ResourceWithImportState, id string) *resource.ImportStateResponse {
+	t.Helper()
+	s := resourceSchema(r)
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+	return resp
+}
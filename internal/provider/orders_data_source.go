@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrdersDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &OrdersDataSource{}
+
+// defaultOrdersLimit is the number of orders read when limit is not set.
+// Each order is read with its own request.
+const defaultOrdersLimit = 20
+
+func NewOrdersDataSource() datasource.DataSource {
+	return &OrdersDataSource{}
+}
+
+type OrdersDataSource struct {
+	client *godaddy.Client
+}
+
+type OrdersDataSourceModel struct {
+	Domain         types.String `tfsdk:"domain"`
+	ProductGroupID types.Int64  `tfsdk:"product_group_id"`
+	CreatedAfter   types.String `tfsdk:"created_after"`
+	CreatedBefore  types.String `tfsdk:"created_before"`
+	Limit          types.Int32  `tfsdk:"limit"`
+	Orders         types.List   `tfsdk:"orders"`
+}
+
+type OrderModel struct {
+	OrderID       types.String  `tfsdk:"order_id"`
+	ParentOrderID types.String  `tfsdk:"parent_order_id"`
+	CreatedAt     types.String  `tfsdk:"created_at"`
+	Currency      types.String  `tfsdk:"currency"`
+	Subtotal      types.Float64 `tfsdk:"subtotal"`
+	Taxes         types.Float64 `tfsdk:"taxes"`
+	Total         types.Float64 `tfsdk:"total"`
+	Domains       types.List    `tfsdk:"domains"`
+	Items         types.List    `tfsdk:"items"`
+}
+
+type OrderItemModel struct {
+	Label      types.String  `tfsdk:"label"`
+	Quantity   types.Int64   `tfsdk:"quantity"`
+	Period     types.Int64   `tfsdk:"period"`
+	PeriodUnit types.String  `tfsdk:"period_unit"`
+	Total      types.Float64 `tfsdk:"total"`
+	Domains    types.List    `tfsdk:"domains"`
+}
+
+func (d *OrdersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orders"
+}
+
+func (d *OrdersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for the orders placed by the account, with their prices and the domains they were for.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only include orders for this domain name.",
+				Optional:            true,
+			},
+			"product_group_id": schema.Int64Attribute{
+				MarkdownDescription: "Only include orders with products of this product group.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only include orders placed at or after this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only include orders placed before this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of orders to return, newest first. Every order is read with a request " +
+					"of its own. Defaults to `20`.",
+				Optional: true,
+			},
+			"orders": schema.ListNestedAttribute{
+				MarkdownDescription: "The orders, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"order_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the order.",
+							Computed:            true,
+						},
+						"parent_order_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the order this one was split from, if any.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the order was placed (RFC 3339).",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Currency of the amounts.",
+							Computed:            true,
+						},
+						"subtotal": schema.Float64Attribute{
+							MarkdownDescription: "Amount before taxes and fees, in currency units.",
+							Computed:            true,
+						},
+						"taxes": schema.Float64Attribute{
+							MarkdownDescription: "Taxes charged, in currency units.",
+							Computed:            true,
+						},
+						"total": schema.Float64Attribute{
+							MarkdownDescription: "Amount charged, in currency units.",
+							Computed:            true,
+						},
+						"domains": schema.ListAttribute{
+							MarkdownDescription: "Domain names of all items of the order.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"items": schema.ListNestedAttribute{
+							MarkdownDescription: "The lines of the order.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"label": schema.StringAttribute{
+										MarkdownDescription: "Description of the product.",
+										Computed:            true,
+									},
+									"quantity": schema.Int64Attribute{
+										MarkdownDescription: "Number of units ordered.",
+										Computed:            true,
+									},
+									"period": schema.Int64Attribute{
+										MarkdownDescription: "Length of the term ordered, in `period_unit`.",
+										Computed:            true,
+									},
+									"period_unit": schema.StringAttribute{
+										MarkdownDescription: "Unit of `period`, such as `YEAR` or `MONTH`.",
+										Computed:            true,
+									},
+									"total": schema.Float64Attribute{
+										MarkdownDescription: "Amount charged for the line, in currency units.",
+										Computed:            true,
+									},
+									"domains": schema.ListAttribute{
+										MarkdownDescription: "Domain names the line was for.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrdersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data OrdersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{
+		"created_after":  data.CreatedAfter,
+		"created_before": data.CreatedBefore,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp (e.g. 2026-12-31T23:59:59Z): %s", name, err),
+			)
+		}
+	}
+
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() && data.Limit.ValueInt32() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid Value",
+			fmt.Sprintf("limit must be at least 1, got %d.", data.Limit.ValueInt32()),
+		)
+	}
+}
+
+func (d *OrdersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *OrdersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrdersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := godaddy.OrderListOptions{
+		Domain:         data.Domain.ValueString(),
+		ProductGroupID: int(data.ProductGroupID.ValueInt64()),
+		Limit:          defaultOrdersLimit,
+	}
+	if !data.Limit.IsNull() {
+		opts.Limit = int(data.Limit.ValueInt32())
+	}
+	// The timestamps were validated with the configuration, but may have been
+	// unknown at that point
+	if !data.CreatedAfter.IsNull() {
+		after, err := time.Parse(time.RFC3339, data.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid Timestamp", err.Error())
+			return
+		}
+		opts.PeriodStart = after
+	}
+	if !data.CreatedBefore.IsNull() {
+		before, err := time.Parse(time.RFC3339, data.CreatedBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid Timestamp", err.Error())
+			return
+		}
+		opts.PeriodEnd = before
+	}
+
+	summaries, err := d.client.ListOrders(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Orders",
+			fmt.Sprintf("Could not list orders: %s", err),
+		)
+		return
+	}
+
+	// Only the order details have prices and domain names
+	models := make([]OrderModel, len(summaries))
+	for i, summary := range summaries {
+		order, err := d.client.GetOrder(ctx, summary.OrderID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Order",
+				fmt.Sprintf("Could not read order %s: %s", summary.OrderID, err),
+			)
+			return
+		}
+
+		domains := []string{}
+		items := make([]OrderItemModel, len(order.Items))
+		for j, item := range order.Items {
+			domains = append(domains, item.Domains...)
+
+			if item.Domains == nil {
+				item.Domains = []string{}
+			}
+			itemDomains, diags := types.ListValueFrom(ctx, types.StringType, item.Domains)
+			resp.Diagnostics.Append(diags...)
+			items[j] = OrderItemModel{
+				Label:      types.StringValue(item.Label),
+				Quantity:   types.Int64Value(int64(item.Quantity)),
+				Period:     types.Int64Null(),
+				PeriodUnit: optionalString(item.PeriodUnit),
+				Total:      types.Float64Value(godaddy.FromMicros(item.Pricing.Total)),
+				Domains:    itemDomains,
+			}
+			if item.Period > 0 {
+				items[j].Period = types.Int64Value(int64(item.Period))
+			}
+		}
+
+		domainsList, diags := types.ListValueFrom(ctx, types.StringType, domains)
+		resp.Diagnostics.Append(diags...)
+		itemsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: orderItemAttributeTypes()}, items)
+		resp.Diagnostics.Append(diags...)
+
+		models[i] = OrderModel{
+			OrderID:       types.StringValue(summary.OrderID),
+			ParentOrderID: optionalString(order.ParentOrderID),
+			CreatedAt:     optionalTime(summary.CreatedAt),
+			Currency:      types.StringValue(order.Currency),
+			Subtotal:      types.Float64Value(godaddy.FromMicros(order.Pricing.Subtotal)),
+			Taxes:         types.Float64Value(godaddy.FromMicros(order.Pricing.Taxes)),
+			Total:         types.Float64Value(godaddy.FromMicros(order.Pricing.Total)),
+			Domains:       domainsList,
+			Items:         itemsList,
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ordersList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: orderAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Orders = ordersList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func orderItemAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"label":       types.StringType,
+		"quantity":    types.Int64Type,
+		"period":      types.Int64Type,
+		"period_unit": types.StringType,
+		"total":       types.Float64Type,
+		"domains":     types.ListType{ElemType: types.StringType},
+	}
+}
+
+func orderAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"order_id":        types.StringType,
+		"parent_order_id": types.StringType,
+		"created_at":      types.StringType,
+		"currency":        types.StringType,
+		"subtotal":        types.Float64Type,
+		"taxes":           types.Float64Type,
+		"total":           types.Float64Type,
+		"domains":         types.ListType{ElemType: types.StringType},
+		"items":           types.ListType{ElemType: types.ObjectType{AttrTypes: orderItemAttributeTypes()}},
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrdersDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     tftypes.Value
+		wantLimit string
+	}{
+		{name: "default limit", limit: tftypes.NewValue(tftypes.Number, nil), wantLimit: "20"},
+		{name: "limit", limit: tftypes.NewValue(tftypes.Number, 1), wantLimit: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var details []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/v1/orders":
+					if got := r.URL.Query().Get("limit"); got != tt.wantLimit {
+						t.Errorf("limit = %q, want %q", got, tt.wantLimit)
+					}
+					if got := r.URL.Query().Get("domain"); got != "example.com" {
+						t.Errorf("domain = %q, want example.com", got)
+					}
+					w.Write([]byte(`{"orders":[
+						{"orderId":"1002","createdAt":"2026-03-01T10:00:00Z","currency":"USD"},
+						{"orderId":"1001","createdAt":"2025-03-01T10:00:00Z","currency":"USD"}
+					],"pagination":{"total":2}}`))
+				case strings.HasPrefix(r.URL.Path, "/v1/orders/"):
+					id := strings.TrimPrefix(r.URL.Path, "/v1/orders/")
+					details = append(details, id)
+					w.Write([]byte(`{"orderId":"` + id + `","currency":"USD",
+						"items":[{"label":".COM Domain Name Renewal","quantity":1,"period":1,"periodUnit":"YEAR",
+							"domains":["example.com"],"pricing":{"total":11990000}}],
+						"pricing":{"subtotal":11990000,"taxes":1000000,"total":12990000}}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			resp := readDataSource(t, &OrdersDataSource{client: newTestClient(server.URL)}, map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+				"limit":  tt.limit,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var data OrdersDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			var orders []OrderModel
+			resp.Diagnostics.Append(data.Orders.ElementsAs(context.Background(), &orders, false)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// Limited orders are never read in detail
+			if len(orders) != len(details) {
+				t.Errorf("read %v in detail for %d orders", details, len(orders))
+			}
+			if len(orders) == 0 || orders[0].OrderID.ValueString() != "1002" || orders[0].Total.ValueFloat64() != 12.99 {
+				t.Fatalf("orders = %+v, want order 1002 first with its total", orders)
+			}
+			if domains := orders[0].Domains.Elements(); len(domains) != 1 {
+				t.Errorf("domains = %s, want example.com", orders[0].Domains)
+			}
+		})
+	}
+}
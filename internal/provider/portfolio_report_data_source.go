@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Portfolio report rules, named after the attributes that enable them
+const (
+	portfolioRuleLocked             = "locked"
+	portfolioRuleTransferProtection = "transfer_protection"
+	portfolioRuleNameservers        = "nameservers"
+	portfolioRuleDNSSEC             = "dnssec"
+)
+
+var _ datasource.DataSource = &PortfolioReportDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PortfolioReportDataSource{}
+
+func NewPortfolioReportDataSource() datasource.DataSource {
+	return &PortfolioReportDataSource{}
+}
+
+type PortfolioReportDataSource struct {
+	client *godaddy.Client
+}
+
+type PortfolioReportDataSourceModel struct {
+	TLDs                      types.Set    `tfsdk:"tlds"`
+	NameRegex                 types.String `tfsdk:"name_regex"`
+	RequireLocked             types.Bool   `tfsdk:"require_locked"`
+	RequireTransferProtection types.Bool   `tfsdk:"require_transfer_protection"`
+	RequireDNSSEC             types.Bool   `tfsdk:"require_dnssec"`
+	ApprovedNameservers       types.Set    `tfsdk:"approved_nameservers"`
+	Compliant                 types.Bool   `tfsdk:"compliant"`
+	NonCompliantDomains       types.List   `tfsdk:"non_compliant_domains"`
+	Domains                   types.List   `tfsdk:"domains"`
+}
+
+type PortfolioDomainModel struct {
+	Domain     types.String `tfsdk:"domain"`
+	Compliant  types.Bool   `tfsdk:"compliant"`
+	Violations types.List   `tfsdk:"violations"`
+}
+
+type PortfolioViolationModel struct {
+	Rule    types.String `tfsdk:"rule"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (d *PortfolioReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_portfolio_report"
+}
+
+func (d *PortfolioReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source checking the active domains of the account against security rules.",
+		Attributes: map[string]schema.Attribute{
+			"tlds": schema.SetAttribute{
+				MarkdownDescription: "Only check domains with these TLDs (e.g. `com`, `co.uk`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only check domains whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"require_locked": schema.BoolAttribute{
+				MarkdownDescription: "Report domains that are not locked. Defaults to `false`.",
+				Optional:            true,
+			},
+			"require_transfer_protection": schema.BoolAttribute{
+				MarkdownDescription: "Report domains without transfer protection. Defaults to `false`.",
+				Optional:            true,
+			},
+			"require_dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Report domains without DNSSEC. Defaults to `false`.",
+				Optional:            true,
+			},
+			"approved_nameservers": schema.SetAttribute{
+				MarkdownDescription: "Report domains using a nameserver that is not in this set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"compliant": schema.BoolAttribute{
+				MarkdownDescription: "Whether every checked domain follows every enabled rule.",
+				Computed:            true,
+			},
+			"non_compliant_domains": schema.ListAttribute{
+				MarkdownDescription: "Names of the domains with at least one violation, sorted alphabetically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Every checked domain with its violations, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain name.",
+							Computed:            true,
+						},
+						"compliant": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain follows every enabled rule.",
+							Computed:            true,
+						},
+						"violations": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules the domain breaks.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"rule": schema.StringAttribute{
+										MarkdownDescription: "The rule: `locked`, `transfer_protection`, `nameservers` or `dnssec`.",
+										Computed:            true,
+									},
+									"message": schema.StringAttribute{
+										MarkdownDescription: "Description of the violation.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PortfolioReportDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PortfolioReportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Could not compile %q: %s", data.NameRegex.ValueString(), err),
+			)
+		}
+	}
+}
+
+func (d *PortfolioReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *PortfolioReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PortfolioReportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := portfolioRules{
+		locked:             data.RequireLocked.ValueBool(),
+		transferProtection: data.RequireTransferProtection.ValueBool(),
+		dnssec:             data.RequireDNSSEC.ValueBool(),
+	}
+	var filter domainFilter
+	if !data.TLDs.IsNull() {
+		resp.Diagnostics.Append(data.TLDs.ElementsAs(ctx, &filter.tlds, false)...)
+	}
+	if !data.ApprovedNameservers.IsNull() {
+		var nameservers []string
+		resp.Diagnostics.Append(data.ApprovedNameservers.ElementsAs(ctx, &nameservers, false)...)
+		rules.nameservers = make(map[string]bool, len(nameservers))
+		for _, nameserver := range nameservers {
+			rules.nameservers[normalizeNameserver(nameserver)] = true
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	domains, err := d.client.ListDomainsWithOptions(ctx, godaddy.DomainListOptions{
+		Statuses:           []string{"ACTIVE"},
+		IncludeNameservers: rules.nameservers != nil,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Domains",
+			fmt.Sprintf("Could not list domains: %s", err),
+		)
+		return
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Domain.Domain < domains[j].Domain.Domain
+	})
+
+	nonCompliant := []string{}
+	models := []PortfolioDomainModel{}
+	for _, domain := range domains {
+		if !filter.matches(domain.Domain) {
+			continue
+		}
+
+		// The DNSSEC state is only returned for a single domain
+		if rules.dnssec {
+			detail, err := d.client.GetDomain(ctx, domain.Domain.Domain)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Domain",
+					fmt.Sprintf("Could not read domain %s: %s", domain.Domain.Domain, err),
+				)
+				return
+			}
+			domain.DNSSec = detail.DNSSec
+		}
+
+		violations := rules.check(domain)
+		if len(violations) > 0 {
+			nonCompliant = append(nonCompliant, domain.Domain.Domain)
+		}
+
+		violationModels := make([]PortfolioViolationModel, len(violations))
+		for i, violation := range violations {
+			violationModels[i] = PortfolioViolationModel{
+				Rule:    types.StringValue(violation.rule),
+				Message: types.StringValue(violation.message),
+			}
+		}
+		violationsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: portfolioViolationAttributeTypes()}, violationModels)
+		resp.Diagnostics.Append(diags...)
+
+		models = append(models, PortfolioDomainModel{
+			Domain:     types.StringValue(domain.Domain.Domain),
+			Compliant:  types.BoolValue(len(violations) == 0),
+			Violations: violationsList,
+		})
+	}
+
+	nonCompliantList, diags := types.ListValueFrom(ctx, types.StringType, nonCompliant)
+	resp.Diagnostics.Append(diags...)
+	domainsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: portfolioDomainAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Compliant = types.BoolValue(len(nonCompliant) == 0)
+	data.NonCompliantDomains = nonCompliantList
+	data.Domains = domainsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// portfolioRules holds the enabled rules. nameservers holds the approved
+// nameservers, normalized; nil disables the nameserver rule.
+type portfolioRules struct {
+	locked             bool
+	transferProtection bool
+	dnssec             bool
+	nameservers        map[string]bool
+}
+
+type portfolioViolation struct {
+	rule    string
+	message string
+}
+
+func (r portfolioRules) check(domain godaddy.DomainDetail) []portfolioViolation {
+	var violations []portfolioViolation
+
+	if r.locked && !domain.Locked {
+		violations = append(violations, portfolioViolation{portfolioRuleLocked, "domain is not locked"})
+	}
+	if r.transferProtection && !domain.TransferProtected {
+		violations = append(violations, portfolioViolation{portfolioRuleTransferProtection, "transfer protection is off"})
+	}
+
+	if r.nameservers != nil {
+		var unapproved []string
+		for _, nameserver := range domain.Nameservers {
+			if !r.nameservers[normalizeNameserver(nameserver)] {
+				unapproved = append(unapproved, nameserver)
+			}
+		}
+		switch {
+		case len(domain.Nameservers) == 0:
+			violations = append(violations, portfolioViolation{portfolioRuleNameservers, "domain has no nameservers"})
+		case len(unapproved) > 0:
+			violations = append(violations, portfolioViolation{portfolioRuleNameservers, fmt.Sprintf("unapproved nameservers: %s", strings.Join(unapproved, ", "))})
+		}
+	}
+
+	if r.dnssec && (domain.DNSSec == nil || !domain.DNSSec.Enabled) {
+		violations = append(violations, portfolioViolation{portfolioRuleDNSSEC, "DNSSEC is not enabled"})
+	}
+
+	return violations
+}
+
+// normalizeNameserver makes nameservers comparable regardless of case and of
+// a trailing dot
+func normalizeNameserver(nameserver string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(nameserver)), ".")
+}
+
+func portfolioViolationAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rule":    types.StringType,
+		"message": types.StringType,
+	}
+}
+
+func portfolioDomainAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain":     types.StringType,
+		"compliant":  types.BoolType,
+		"violations": types.ListType{ElemType: types.ObjectType{AttrTypes: portfolioViolationAttributeTypes()}},
+	}
+}
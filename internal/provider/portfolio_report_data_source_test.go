@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+)
+
+func TestPortfolioRules_Check(t *testing.T) {
+	compliant := godaddy.DomainDetail{
+		Domain:      godaddy.Domain{Domain: "example.com", Locked: true, TransferProtected: true},
+		Nameservers: []string{"NS1.Example.net.", "ns2.example.net"},
+		DNSSec:      &godaddy.DNSSec{Enabled: true},
+	}
+	all := portfolioRules{
+		locked:             true,
+		transferProtection: true,
+		dnssec:             true,
+		nameservers:        map[string]bool{"ns1.example.net": true, "ns2.example.net": true},
+	}
+
+	tests := []struct {
+		name   string
+		rules  portfolioRules
+		domain func(godaddy.DomainDetail) godaddy.DomainDetail
+		want   []string
+	}{
+		{
+			name:  "compliant",
+			rules: all,
+		},
+		{
+			name:   "no rules enabled",
+			domain: func(d godaddy.DomainDetail) godaddy.DomainDetail { d.Locked = false; d.DNSSec = nil; return d },
+		},
+		{
+			name:  "unlocked and unprotected",
+			rules: all,
+			domain: func(d godaddy.DomainDetail) godaddy.DomainDetail {
+				d.Locked = false
+				d.TransferProtected = false
+				return d
+			},
+			want: []string{portfolioRuleLocked, portfolioRuleTransferProtection},
+		},
+		{
+			name:  "unapproved nameserver",
+			rules: all,
+			domain: func(d godaddy.DomainDetail) godaddy.DomainDetail {
+				d.Nameservers = []string{"ns1.example.net", "ns1.other.org"}
+				return d
+			},
+			want: []string{portfolioRuleNameservers},
+		},
+		{
+			name:   "no nameservers",
+			rules:  portfolioRules{nameservers: map[string]bool{}},
+			domain: func(d godaddy.DomainDetail) godaddy.DomainDetail { d.Nameservers = nil; return d },
+			want:   []string{portfolioRuleNameservers},
+		},
+		{
+			name:   "dnssec unknown",
+			rules:  portfolioRules{dnssec: true},
+			domain: func(d godaddy.DomainDetail) godaddy.DomainDetail { d.DNSSec = nil; return d },
+			want:   []string{portfolioRuleDNSSEC},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain := compliant
+			if tt.domain != nil {
+				domain = tt.domain(domain)
+			}
+
+			violations := tt.rules.check(domain)
+			if len(violations) != len(tt.want) {
+				t.Fatalf("expected violations %v, got %v", tt.want, violations)
+			}
+			for i, rule := range tt.want {
+				if violations[i].rule != rule {
+					t.Errorf("violation %d: expected rule %s, got %s", i, rule, violations[i].rule)
+				}
+			}
+		})
+	}
+}
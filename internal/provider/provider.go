@@ -5,18 +5,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure GoDaddyProvider satisfies various provider interfaces.
 var _ provider.Provider = &GoDaddyProvider{}
+var _ provider.ProviderWithEphemeralResources = &GoDaddyProvider{}
+var _ provider.ProviderWithActions = &GoDaddyProvider{}
 
 // GoDaddyProvider defines the provider implementation.
 type GoDaddyProvider struct {
@@ -28,9 +37,48 @@ type GoDaddyProvider struct {
 
 // GoDaddyProviderModel describes the provider data model.
 type GoDaddyProviderModel struct {
-	APIKey      types.String `tfsdk:"api_key"`
-	APISecret   types.String `tfsdk:"api_secret"`
-	Environment types.String `tfsdk:"environment"`
+	APIKey           types.String `tfsdk:"api_key"`
+	APISecret        types.String `tfsdk:"api_secret"`
+	Environment      types.String `tfsdk:"environment"`
+	CustomerID       types.String `tfsdk:"customer_id"`
+	ContactProfiles  types.Map    `tfsdk:"contact_profiles"`
+	ValidateContacts types.Bool   `tfsdk:"validate_contacts"`
+
+	WaitForDomainActions types.Bool   `tfsdk:"wait_for_domain_actions"`
+	DomainActionTimeout  types.String `tfsdk:"domain_action_timeout"`
+
+	ExpiryWarningDays types.Int64 `tfsdk:"expiry_warning_days"`
+}
+
+// GoDaddyProviderData is passed to resources and data sources when the
+// provider is configured.
+type GoDaddyProviderData struct {
+	Client           *godaddy.Client
+	ContactProfiles  map[string]ContactProfile
+	ValidateContacts bool
+	Countries        *countryCache
+
+	// Actions is nil unless wait_for_domain_actions is enabled
+	Actions *domainActionWaiter
+	// Expiry is nil when expiry_warning_days is 0
+	Expiry *domainExpiryChecker
+}
+
+// ContactProfile is a named set of domain contacts defined in the provider
+// configuration. Roles left out of the profile are nil.
+type ContactProfile struct {
+	Registrant *godaddy.DomainContact
+	Admin      *godaddy.DomainContact
+	Tech       *godaddy.DomainContact
+	Billing    *godaddy.DomainContact
+}
+
+// ContactProfileModel describes one entry of the contact_profiles attribute.
+type ContactProfileModel struct {
+	Registrant types.Object `tfsdk:"registrant"`
+	Admin      types.Object `tfsdk:"admin"`
+	Tech       types.Object `tfsdk:"tech"`
+	Billing    types.Object `tfsdk:"billing"`
 }
 
 func (p *GoDaddyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,10 +104,79 @@ func (p *GoDaddyProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				MarkdownDescription: "GoDaddy API environment. Valid values are 'production' (default) and 'test'. Can also be set via GODADDY_ENVIRONMENT environment variable.",
 				Optional:            true,
 			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "GoDaddy customer ID, required by resources that use the v2 domains API (e.g. `godaddy_domain_dnssec`). Can also be set via GODADDY_CUSTOMER_ID environment variable.",
+				Optional:            true,
+			},
+			"validate_contacts": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check domain contacts with GoDaddy's contact validation endpoint at plan time. " +
+					"Defaults to `false`.",
+				Optional: true,
+			},
+			"wait_for_domain_actions": schema.BoolAttribute{
+				MarkdownDescription: "Whether resources that change domains at the registry wait until GoDaddy reports the " +
+					"resulting actions as finished, so that failures are reported by the apply. Requires `customer_id`. " +
+					"Defaults to `false`.",
+				Optional: true,
+			},
+			"domain_action_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for a domain action when `wait_for_domain_actions` is enabled, " +
+					"as a Go duration. Defaults to `10m`.",
+				Optional: true,
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "Warn when a domain read by `godaddy_domain` expires within this many days, and fail " +
+					"when its renewal deadline has passed with auto-renew off. Set to `0` to turn the checks off. " +
+					"Defaults to `30`.",
+				Optional: true,
+			},
+			"contact_profiles": schema.MapNestedAttribute{
+				MarkdownDescription: "Named contact profiles that `godaddy_domain` resources can reference with `contact_profile`. " +
+					"Each profile may define any of the `registrant`, `admin`, `tech` and `billing` contacts.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"registrant": schema.SingleNestedAttribute{
+							MarkdownDescription: "Registrant contact information.",
+							Optional:            true,
+							Attributes:          providerContactAttributes(),
+						},
+						"admin": schema.SingleNestedAttribute{
+							MarkdownDescription: "Administrative contact information.",
+							Optional:            true,
+							Attributes:          providerContactAttributes(),
+						},
+						"tech": schema.SingleNestedAttribute{
+							MarkdownDescription: "Technical contact information.",
+							Optional:            true,
+							Attributes:          providerContactAttributes(),
+						},
+						"billing": schema.SingleNestedAttribute{
+							MarkdownDescription: "Billing contact information.",
+							Optional:            true,
+							Attributes:          providerContactAttributes(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// providerContactAttributes is contactAttributes for the provider schema.
+func providerContactAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(contactFields))
+	for _, field := range contactFields {
+		attributes[field.name] = schema.StringAttribute{
+			MarkdownDescription: field.description,
+			Required:            field.required,
+			Optional:            !field.required,
+			Validators:          field.validators,
+		}
+	}
+	return attributes
+}
+
 func (p *GoDaddyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data GoDaddyProviderModel
 
@@ -85,6 +202,11 @@ func (p *GoDaddyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		environment = data.Environment.ValueString()
 	}
 
+	customerID := os.Getenv("GODADDY_CUSTOMER_ID")
+	if !data.CustomerID.IsNull() {
+		customerID = data.CustomerID.ValueString()
+	}
+
 	// Validate required fields
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
@@ -111,16 +233,140 @@ func (p *GoDaddyProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if environment == "test" {
 		opts = append(opts, godaddy.WithTestEnvironment())
 	}
+	if customerID != "" {
+		opts = append(opts, godaddy.WithCustomerID(customerID))
+	}
+
+	profiles, diags := contactProfilesFromConfig(ctx, data.ContactProfiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionTimeout := godaddy.DefaultWaitTimeout
+	if !data.DomainActionTimeout.IsNull() {
+		parsed, err := time.ParseDuration(data.DomainActionTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("domain_action_timeout"),
+				"Invalid Domain Action Timeout",
+				fmt.Sprintf("Could not parse %q as a duration: %s", data.DomainActionTimeout.ValueString(), err),
+			)
+			return
+		}
+		actionTimeout = parsed
+	}
+
+	if data.WaitForDomainActions.ValueBool() && customerID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_domain_actions"),
+			"Missing Customer ID",
+			"wait_for_domain_actions uses the v2 domains API and requires customer_id to be set.",
+		)
+		return
+	}
+
+	expiryWarningDays := int64(defaultExpiryWarningDays)
+	if !data.ExpiryWarningDays.IsNull() {
+		expiryWarningDays = data.ExpiryWarningDays.ValueInt64()
+	}
+	if expiryWarningDays < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiry_warning_days"),
+			"Invalid Expiry Warning Days",
+			fmt.Sprintf("expiry_warning_days can't be negative, got %d.", expiryWarningDays),
+		)
+		return
+	}
 
 	client := godaddy.NewClient(apiKey, apiSecret, opts...)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &GoDaddyProviderData{
+		Client:           client,
+		ContactProfiles:  profiles,
+		ValidateContacts: data.ValidateContacts.ValueBool(),
+		Countries:        newCountryCache(),
+		Expiry:           newDomainExpiryChecker(int(expiryWarningDays)),
+	}
+	if data.WaitForDomainActions.ValueBool() {
+		providerData.Actions = &domainActionWaiter{
+			client: client,
+			opts:   godaddy.WaitOptions{Timeout: actionTimeout},
+		}
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ActionData = providerData
+}
+
+// contactProfilesFromConfig converts the contact_profiles attribute into
+// ContactProfile values keyed by profile name.
+func contactProfilesFromConfig(ctx context.Context, value types.Map) (map[string]ContactProfile, diag.Diagnostics) {
+	profiles := map[string]ContactProfile{}
+	if value.IsNull() || value.IsUnknown() {
+		return profiles, nil
+	}
+
+	var raw map[string]types.Object
+	diags := value.ElementsAs(ctx, &raw, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for name, obj := range raw {
+		if obj.IsNull() || obj.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("contact_profiles").AtMapKey(name),
+				"Invalid Contact Profile",
+				fmt.Sprintf("Contact profile %q must be known when the provider is configured and can't be null.", name),
+			)
+			continue
+		}
+
+		var model ContactProfileModel
+		diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for role, contact := range map[string]types.Object{
+			contactRoleRegistrant: model.Registrant,
+			contactRoleAdmin:      model.Admin,
+			contactRoleTech:       model.Tech,
+			contactRoleBilling:    model.Billing,
+		} {
+			if contact.IsUnknown() {
+				diags.AddAttributeError(
+					path.Root("contact_profiles").AtMapKey(name).AtName(role),
+					"Invalid Contact Profile",
+					fmt.Sprintf("The %s contact of profile %q must be known when the provider is configured.", role, name),
+				)
+			}
+		}
+
+		profiles[name] = ContactProfile{
+			Registrant: objectToContact(model.Registrant),
+			Admin:      objectToContact(model.Admin),
+			Tech:       objectToContact(model.Tech),
+			Billing:    objectToContact(model.Billing),
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return profiles, diags
 }
 
 func (p *GoDaddyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDomainResource,
 		NewDNSRecordResource,
+		NewDomainDNSSECResource,
+		NewDomainContactsResource,
+		NewDomainTransferInResource,
+		NewDomainHostResource,
+		NewDomainForwardingResource,
+		NewSubaccountResource,
 	}
 }
 
@@ -128,6 +374,30 @@ func (p *GoDaddyProvider) DataSources(ctx context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		NewDomainDataSource,
 		NewDNSRecordsDataSource,
+		NewDomainActionsDataSource,
+		NewDomainsDataSource,
+		NewDomainAvailabilityDataSource,
+		NewDomainSuggestionsDataSource,
+		NewTLDsDataSource,
+		NewTLDPurchaseSchemaDataSource,
+		NewDomainAgreementsDataSource,
+		NewSubscriptionsDataSource,
+		NewOrdersDataSource,
+		NewRenewalForecastDataSource,
+		NewPortfolioReportDataSource,
+	}
+}
+
+func (p *GoDaddyProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDomainAuthCodeEphemeralResource,
+	}
+}
+
+func (p *GoDaddyProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDomainRenewAction,
+		NewDomainRedeemAction,
 	}
 }
 
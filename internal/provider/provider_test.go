@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -30,3 +33,67 @@ func testAccPreCheck(t *testing.T) {
 		t.Skip("GODADDY_API_SECRET must be set for acceptance tests")
 	}
 }
+
+func TestContactProfilesFromConfig(t *testing.T) {
+	ctx := context.Background()
+	contactType := types.ObjectType{AttrTypes: contactAttributeTypes()}
+	profileType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		contactRoleRegistrant: contactType,
+		contactRoleAdmin:      contactType,
+		contactRoleTech:       contactType,
+		contactRoleBilling:    contactType,
+	}}
+
+	profiles, diags := contactProfilesFromConfig(ctx, types.MapNull(profileType))
+	if diags.HasError() || profiles == nil || len(profiles) != 0 {
+		t.Fatalf("contactProfilesFromConfig(null) = %v, %v, want an empty map", profiles, diags)
+	}
+
+	value := types.MapValueMust(profileType, map[string]attr.Value{
+		"corp": types.ObjectValueMust(profileType.AttrTypes, map[string]attr.Value{
+			contactRoleRegistrant: contactToObject(testContact("Jane", "jane@example.com")),
+			contactRoleAdmin:      types.ObjectNull(contactAttributeTypes()),
+			contactRoleTech:       contactToObject(testContact("Tech", "tech@example.com")),
+			contactRoleBilling:    types.ObjectNull(contactAttributeTypes()),
+		}),
+	})
+
+	profiles, diags = contactProfilesFromConfig(ctx, value)
+	if diags.HasError() {
+		t.Fatalf("contactProfilesFromConfig() diagnostics = %v", diags)
+	}
+	corp, ok := profiles["corp"]
+	if !ok || len(profiles) != 1 {
+		t.Fatalf("contactProfilesFromConfig() = %v, want the corp profile", profiles)
+	}
+	if corp.Registrant == nil || corp.Registrant.Email != "jane@example.com" {
+		t.Errorf("registrant = %v, want jane@example.com", corp.Registrant)
+	}
+	if corp.Tech == nil || corp.Tech.Email != "tech@example.com" {
+		t.Errorf("tech = %v, want tech@example.com", corp.Tech)
+	}
+	if corp.Admin != nil || corp.Billing != nil {
+		t.Errorf("admin = %v, billing = %v, want roles left out of the profile to be nil", corp.Admin, corp.Billing)
+	}
+
+	invalid := map[string]attr.Value{
+		"null profile":    types.ObjectNull(profileType.AttrTypes),
+		"unknown profile": types.ObjectUnknown(profileType.AttrTypes),
+		"unknown role": types.ObjectValueMust(profileType.AttrTypes, map[string]attr.Value{
+			contactRoleRegistrant: types.ObjectUnknown(contactAttributeTypes()),
+			contactRoleAdmin:      types.ObjectNull(contactAttributeTypes()),
+			contactRoleTech:       types.ObjectNull(contactAttributeTypes()),
+			contactRoleBilling:    types.ObjectNull(contactAttributeTypes()),
+		}),
+	}
+	for name, profile := range invalid {
+		t.Run(name, func(t *testing.T) {
+			value := types.MapValueMust(profileType, map[string]attr.Value{"corp": profile})
+
+			profiles, diags := contactProfilesFromConfig(ctx, value)
+			if !diags.HasError() {
+				t.Errorf("contactProfilesFromConfig() = %v, want an error", profiles)
+			}
+		})
+	}
+}
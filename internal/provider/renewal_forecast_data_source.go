@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultForecastMonths is the forecast window when months is not set
+const defaultForecastMonths = 12
+
+var _ datasource.DataSource = &RenewalForecastDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &RenewalForecastDataSource{}
+
+func NewRenewalForecastDataSource() datasource.DataSource {
+	return &RenewalForecastDataSource{}
+}
+
+type RenewalForecastDataSource struct {
+	client *godaddy.Client
+}
+
+type RenewalForecastDataSourceModel struct {
+	Months          types.Int64   `tfsdk:"months"`
+	Currency        types.String  `tfsdk:"currency"`
+	TotalCount      types.Int64   `tfsdk:"total_count"`
+	TotalCost       types.Float64 `tfsdk:"total_cost"`
+	AutoRenewCost   types.Float64 `tfsdk:"auto_renew_cost"`
+	Forecast        types.List    `tfsdk:"forecast"`
+	AtRisk          types.List    `tfsdk:"at_risk"`
+	UnpricedDomains types.List    `tfsdk:"unpriced_domains"`
+}
+
+type RenewalForecastMonthModel struct {
+	Month          types.String  `tfsdk:"month"`
+	Count          types.Int64   `tfsdk:"count"`
+	Cost           types.Float64 `tfsdk:"cost"`
+	AutoRenewCount types.Int64   `tfsdk:"auto_renew_count"`
+	AutoRenewCost  types.Float64 `tfsdk:"auto_renew_cost"`
+	Domains        types.List    `tfsdk:"domains"`
+}
+
+type RenewalAtRiskModel struct {
+	Domain        types.String `tfsdk:"domain"`
+	Expires       types.String `tfsdk:"expires"`
+	RenewDeadline types.String `tfsdk:"renew_deadline"`
+}
+
+func (d *RenewalForecastDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_renewal_forecast"
+}
+
+func (d *RenewalForecastDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source forecasting the domain renewals and their cost over the coming months.",
+		Attributes: map[string]schema.Attribute{
+			"months": schema.Int64Attribute{
+				MarkdownDescription: "Number of calendar months to forecast, starting with the current month. Defaults to `12`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Currency of the costs.",
+				Computed:            true,
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of domains expiring in the window.",
+				Computed:            true,
+			},
+			"total_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated cost of renewing every domain expiring in the window.",
+				Computed:            true,
+			},
+			"auto_renew_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated cost of the domains that renew automatically, which is what will be billed.",
+				Computed:            true,
+			},
+			"forecast": schema.ListNestedAttribute{
+				MarkdownDescription: "One entry per month of the window, in order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"month": schema.StringAttribute{
+							MarkdownDescription: "The month, as `YYYY-MM`.",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of domains expiring in the month.",
+							Computed:            true,
+						},
+						"cost": schema.Float64Attribute{
+							MarkdownDescription: "Estimated cost of renewing all of them.",
+							Computed:            true,
+						},
+						"auto_renew_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of them that renew automatically.",
+							Computed:            true,
+						},
+						"auto_renew_cost": schema.Float64Attribute{
+							MarkdownDescription: "Estimated cost of the ones that renew automatically.",
+							Computed:            true,
+						},
+						"domains": schema.ListAttribute{
+							MarkdownDescription: "Names of the domains expiring in the month.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"at_risk": schema.ListNestedAttribute{
+				MarkdownDescription: "Domains whose renewal deadline falls in the window while auto-renew is off, soonest deadline first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain name.",
+							Computed:            true,
+						},
+						"expires": schema.StringAttribute{
+							MarkdownDescription: "When the domain expires (RFC 3339).",
+							Computed:            true,
+						},
+						"renew_deadline": schema.StringAttribute{
+							MarkdownDescription: "Last moment the domain can be renewed (RFC 3339).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"unpriced_domains": schema.ListAttribute{
+				MarkdownDescription: "Domains expiring in the window that GoDaddy reported no price for. They are counted but not included in the costs.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *RenewalForecastDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data RenewalForecastDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Months.IsNull() && !data.Months.IsUnknown() && (data.Months.ValueInt64() < 1 || data.Months.ValueInt64() > 120) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("months"),
+			"Invalid Value",
+			fmt.Sprintf("months must be between 1 and 120, got %d.", data.Months.ValueInt64()),
+		)
+	}
+}
+
+func (d *RenewalForecastDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *RenewalForecastDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RenewalForecastDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	months := defaultForecastMonths
+	if !data.Months.IsNull() {
+		months = int(data.Months.ValueInt64())
+	}
+
+	details, err := d.client.ListDomainsWithOptions(ctx, godaddy.DomainListOptions{
+		StatusGroups: []string{godaddy.StatusGroupRenewable},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Domains",
+			fmt.Sprintf("Could not list domains: %s", err),
+		)
+		return
+	}
+	domains := make([]godaddy.Domain, len(details))
+	for i, detail := range details {
+		domains[i] = detail.Domain
+	}
+
+	subscriptions, err := d.client.ListSubscriptions(ctx, godaddy.SubscriptionListOptions{
+		ProductGroupKeys: []string{godaddy.ProductGroupDomains},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Subscriptions",
+			fmt.Sprintf("Could not list domain subscriptions: %s", err),
+		)
+		return
+	}
+	periods := make(map[string]int, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if domain := subscription.Domain(); domain != "" && subscription.Product.RenewalPeriod > 0 {
+			periods[domain] = subscription.Product.RenewalPeriod
+		}
+	}
+
+	forecast := newRenewalForecast(time.Now().UTC(), months, domains, periods)
+
+	quotes, err := d.client.GetListPriceQuotes(ctx, forecast.domainNames(), 1)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Renewal Prices",
+			fmt.Sprintf("Could not estimate renewal prices: %s", err),
+		)
+		return
+	}
+	currency, unpriced := forecast.price(quotes)
+
+	var totalCount int
+	var totalCost, autoRenewCost float64
+	monthModels := make([]RenewalForecastMonthModel, len(forecast.months))
+	for i, month := range forecast.months {
+		totalCount += len(month.domains)
+		totalCost += month.cost
+		autoRenewCost += month.autoRenewCost
+
+		names := make([]string, len(month.domains))
+		for j, domain := range month.domains {
+			names[j] = domain.Domain
+		}
+		namesList, diags := types.ListValueFrom(ctx, types.StringType, names)
+		resp.Diagnostics.Append(diags...)
+
+		monthModels[i] = RenewalForecastMonthModel{
+			Month:          types.StringValue(month.start.Format("2006-01")),
+			Count:          types.Int64Value(int64(len(month.domains))),
+			Cost:           types.Float64Value(month.cost),
+			AutoRenewCount: types.Int64Value(int64(month.autoRenewCount)),
+			AutoRenewCost:  types.Float64Value(month.autoRenewCost),
+			Domains:        namesList,
+		}
+	}
+
+	atRiskModels := make([]RenewalAtRiskModel, len(forecast.atRisk))
+	for i, domain := range forecast.atRisk {
+		atRiskModels[i] = RenewalAtRiskModel{
+			Domain:        types.StringValue(domain.Domain),
+			Expires:       optionalTime(domain.Expires),
+			RenewDeadline: optionalTime(domain.RenewDeadline),
+		}
+	}
+
+	forecastList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: renewalForecastMonthAttributeTypes()}, monthModels)
+	resp.Diagnostics.Append(diags...)
+	atRiskList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: renewalAtRiskAttributeTypes()}, atRiskModels)
+	resp.Diagnostics.Append(diags...)
+	unpricedList, diags := types.ListValueFrom(ctx, types.StringType, unpriced)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Months = types.Int64Value(int64(months))
+	data.Currency = optionalString(currency)
+	data.TotalCount = types.Int64Value(int64(totalCount))
+	data.TotalCost = types.Float64Value(totalCost)
+	data.AutoRenewCost = types.Float64Value(autoRenewCost)
+	data.Forecast = forecastList
+	data.AtRisk = atRiskList
+	data.UnpricedDomains = unpricedList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// renewalForecast buckets the domains expiring in a window of whole calendar
+// months
+type renewalForecast struct {
+	months  []*renewalForecastMonth
+	atRisk  []godaddy.Domain
+	periods map[string]int
+}
+
+type renewalForecastMonth struct {
+	start          time.Time
+	domains        []godaddy.Domain
+	cost           float64
+	autoRenewCount int
+	autoRenewCost  float64
+}
+
+// newRenewalForecast places every domain expiring in the months starting with
+// the one of now into its month, and collects the domains whose renewal
+// deadline is in the window while auto-renew is off. periods holds the
+// renewal period in years of each domain, keyed by lower-cased name.
+func newRenewalForecast(now time.Time, months int, domains []godaddy.Domain, periods map[string]int) *renewalForecast {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, months, 0)
+
+	forecast := &renewalForecast{periods: periods}
+	for i := 0; i < months; i++ {
+		forecast.months = append(forecast.months, &renewalForecastMonth{start: start.AddDate(0, i, 0)})
+	}
+
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Domain < domains[j].Domain })
+	for _, domain := range domains {
+		if domain.Expires != nil && !domain.Expires.Before(start) && domain.Expires.Before(end) {
+			expires := domain.Expires.UTC()
+			index := (expires.Year()-start.Year())*12 + int(expires.Month()-start.Month())
+			month := forecast.months[index]
+			month.domains = append(month.domains, domain)
+			if domain.RenewAuto {
+				month.autoRenewCount++
+			}
+		}
+
+		if !domain.RenewAuto && domain.RenewDeadline != nil && !domain.RenewDeadline.Before(start) && domain.RenewDeadline.Before(end) {
+			forecast.atRisk = append(forecast.atRisk, domain)
+		}
+	}
+	sort.SliceStable(forecast.atRisk, func(i, j int) bool {
+		return forecast.atRisk[i].RenewDeadline.Before(*forecast.atRisk[j].RenewDeadline)
+	})
+
+	return forecast
+}
+
+// domainNames returns the names of the domains expiring in the window
+func (f *renewalForecast) domainNames() []string {
+	var names []string
+	for _, month := range f.months {
+		for _, domain := range month.domains {
+			names = append(names, domain.Domain)
+		}
+	}
+	return names
+}
+
+// price adds up the cost of each month from yearly list price quotes keyed by
+// lower-cased domain name. It returns the currency of the quotes and the
+// domains that had no quote.
+func (f *renewalForecast) price(quotes map[string]godaddy.ListPriceQuote) (string, []string) {
+	var currency string
+	unpriced := []string{}
+	for _, month := range f.months {
+		for _, domain := range month.domains {
+			name := strings.ToLower(domain.Domain)
+			quote, ok := quotes[name]
+			if !ok {
+				unpriced = append(unpriced, domain.Domain)
+				continue
+			}
+			if currency == "" {
+				currency = quote.Currency
+			}
+
+			years := f.periods[name]
+			if years <= 0 {
+				years = 1
+			}
+			cost := quote.Price * float64(years)
+			month.cost += cost
+			if domain.RenewAuto {
+				month.autoRenewCost += cost
+			}
+		}
+	}
+	return currency, unpriced
+}
+
+func renewalForecastMonthAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"month":            types.StringType,
+		"count":            types.Int64Type,
+		"cost":             types.Float64Type,
+		"auto_renew_count": types.Int64Type,
+		"auto_renew_cost":  types.Float64Type,
+		"domains":          types.ListType{ElemType: types.StringType},
+	}
+}
+
+func renewalAtRiskAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain":         types.StringType,
+		"expires":        types.StringType,
+		"renew_deadline": types.StringType,
+	}
+}
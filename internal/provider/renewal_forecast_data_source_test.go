@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+)
+
+func TestRenewalForecast(t *testing.T) {
+	date := func(year int, month time.Month, day int) *time.Time {
+		value := time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+		return &value
+	}
+	now := time.Date(2026, 11, 15, 9, 0, 0, 0, time.UTC)
+
+	domains := []godaddy.Domain{
+		{Domain: "auto.com", Expires: date(2026, 11, 3), RenewAuto: true},
+		{Domain: "manual.com", Expires: date(2027, 1, 20), RenewDeadline: date(2027, 1, 20)},
+		{Domain: "twoyears.net", Expires: date(2027, 1, 5), RenewAuto: true},
+		{Domain: "unpriced.shop", Expires: date(2026, 12, 1), RenewAuto: true},
+		{Domain: "later.com", Expires: date(2027, 2, 1), RenewDeadline: date(2027, 2, 1)},
+		{Domain: "grace.com", Expires: date(2026, 10, 20), RenewDeadline: date(2026, 11, 30)},
+	}
+	periods := map[string]int{"twoyears.net": 2}
+	quotes := map[string]godaddy.ListPriceQuote{
+		"auto.com":     {Price: 10, Currency: "USD", Period: 1},
+		"manual.com":   {Price: 10, Currency: "USD", Period: 1},
+		"twoyears.net": {Price: 15, Currency: "USD", Period: 1},
+	}
+
+	forecast := newRenewalForecast(now, 3, domains, periods)
+	currency, unpriced := forecast.price(quotes)
+
+	if currency != "USD" {
+		t.Errorf("expected currency USD, got %q", currency)
+	}
+	if len(unpriced) != 1 || unpriced[0] != "unpriced.shop" {
+		t.Errorf("expected unpriced.shop to be unpriced, got %v", unpriced)
+	}
+
+	want := []struct {
+		month          string
+		count          int
+		cost           float64
+		autoRenewCount int
+		autoRenewCost  float64
+	}{
+		{month: "2026-11", count: 1, cost: 10, autoRenewCount: 1, autoRenewCost: 10},
+		{month: "2026-12", count: 1, cost: 0, autoRenewCount: 1, autoRenewCost: 0},
+		{month: "2027-01", count: 2, cost: 40, autoRenewCount: 1, autoRenewCost: 30},
+	}
+	if len(forecast.months) != len(want) {
+		t.Fatalf("expected %d months, got %d", len(want), len(forecast.months))
+	}
+	for i, w := range want {
+		got := forecast.months[i]
+		if month := got.start.Format("2006-01"); month != w.month {
+			t.Errorf("month %d: expected %s, got %s", i, w.month, month)
+		}
+		if len(got.domains) != w.count || got.autoRenewCount != w.autoRenewCount {
+			t.Errorf("%s: expected %d domains (%d auto-renew), got %d (%d)", w.month, w.count, w.autoRenewCount, len(got.domains), got.autoRenewCount)
+		}
+		if math.Abs(got.cost-w.cost) > 0.001 || math.Abs(got.autoRenewCost-w.autoRenewCost) > 0.001 {
+			t.Errorf("%s: expected cost %v (%v auto-renew), got %v (%v)", w.month, w.cost, w.autoRenewCost, got.cost, got.autoRenewCost)
+		}
+	}
+
+	// grace.com already expired but can still be renewed this month;
+	// later.com's deadline is past the window
+	if len(forecast.atRisk) != 2 || forecast.atRisk[0].Domain != "grace.com" || forecast.atRisk[1].Domain != "manual.com" {
+		t.Errorf("expected grace.com and manual.com at risk, got %v", forecast.atRisk)
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SubaccountResource{}
+var _ resource.ResourceWithImportState = &SubaccountResource{}
+
+func NewSubaccountResource() resource.Resource {
+	return &SubaccountResource{}
+}
+
+type SubaccountResource struct {
+	client *godaddy.Client
+}
+
+type SubaccountResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ShopperID       types.String `tfsdk:"shopper_id"`
+	CustomerID      types.String `tfsdk:"customer_id"`
+	NameFirst       types.String `tfsdk:"name_first"`
+	NameLast        types.String `tfsdk:"name_last"`
+	Email           types.String `tfsdk:"email"`
+	MarketID        types.String `tfsdk:"market_id"`
+	ExternalID      types.Int64  `tfsdk:"external_id"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	AuditClientIP   types.String `tfsdk:"audit_client_ip"`
+}
+
+func (r *SubaccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount"
+}
+
+func (r *SubaccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a reseller sub-account (shopper). Requires reseller API credentials and Terraform 1.11 or later for the write-only `password`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The shopper ID of the sub-account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shopper_id": schema.StringAttribute{
+				MarkdownDescription: "The shopper ID of the sub-account, for use in the `X-Shopper-Id` header or as the `subaccountId` of a domain update.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "The customer ID of the sub-account, used by the v2 API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_first": schema.StringAttribute{
+				MarkdownDescription: "First name of the sub-account owner.",
+				Required:            true,
+			},
+			"name_last": schema.StringAttribute{
+				MarkdownDescription: "Last name of the sub-account owner.",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the sub-account owner.",
+				Required:            true,
+				Validators: []validator.String{
+					ContactEmailValidator(),
+				},
+			},
+			"market_id": schema.StringAttribute{
+				MarkdownDescription: "Market (language and region) of the sub-account. Defaults to `en-US`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(godaddy.DefaultMarketID),
+			},
+			"external_id": schema.Int64Attribute{
+				MarkdownDescription: "Your own identifier for the sub-account, such as a customer number. " +
+					"When not configured, the value GoDaddy has for the sub-account is kept.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the sub-account. Write-only: it is never stored in state. " +
+					"It is only sent on creation and when `password_version` changes.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to set `password` on an existing sub-account.",
+				Optional:            true,
+			},
+			"audit_client_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of the person managing the sub-account. GoDaddy requires it to delete the sub-account.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *SubaccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubaccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := godaddy.SubaccountCreate{
+		Email:     data.Email.ValueString(),
+		Password:  password.ValueString(),
+		NameFirst: data.NameFirst.ValueString(),
+		NameLast:  data.NameLast.ValueString(),
+		MarketID:  data.MarketID.ValueString(),
+	}
+	if !data.ExternalID.IsUnknown() {
+		subaccount.ExternalID = data.ExternalID.ValueInt64Pointer()
+	} else {
+		data.ExternalID = types.Int64Null()
+	}
+
+	id, err := r.client.CreateSubaccount(ctx, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Sub-Account",
+			fmt.Sprintf("Could not create sub-account for %s: %s", subaccount.Email, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id.ShopperID)
+	data.ShopperID = types.StringValue(id.ShopperID)
+	data.CustomerID = optionalString(id.CustomerID)
+
+	// The create response does not always include the customer ID
+	if id.CustomerID == "" {
+		if shopper, err := r.client.GetShopper(ctx, id.ShopperID); err == nil {
+			data.CustomerID = optionalString(shopper.CustomerID)
+		}
+	}
+
+	tflog.Trace(ctx, "created sub-account resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubaccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shopper, err := r.client.GetShopper(ctx, data.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Sub-Account",
+			fmt.Sprintf("Could not read sub-account %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.ShopperID = types.StringValue(data.ID.ValueString())
+	data.CustomerID = optionalString(shopper.CustomerID)
+	data.NameFirst = types.StringValue(shopper.NameFirst)
+	data.NameLast = types.StringValue(shopper.NameLast)
+	data.Email = types.StringValue(shopper.Email)
+	if shopper.MarketID != "" {
+		data.MarketID = types.StringValue(shopper.MarketID)
+	}
+	data.ExternalID = types.Int64PointerValue(shopper.ExternalID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SubaccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shopperID := state.ID.ValueString()
+	if data.ExternalID.IsUnknown() {
+		data.ExternalID = state.ExternalID
+	}
+	update := godaddy.ShopperUpdate{
+		Email:      data.Email.ValueString(),
+		NameFirst:  data.NameFirst.ValueString(),
+		NameLast:   data.NameLast.ValueString(),
+		ExternalID: data.ExternalID.ValueInt64Pointer(),
+		MarketID:   data.MarketID.ValueString(),
+	}
+	if err := r.client.UpdateShopper(ctx, shopperID, update); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Sub-Account",
+			fmt.Sprintf("Could not update sub-account %s: %s", shopperID, err),
+		)
+		return
+	}
+
+	if !data.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.client.SetShopperPassword(ctx, shopperID, password.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Sub-Account Password",
+				fmt.Sprintf("Could not set the password of sub-account %s: %s", shopperID, err),
+			)
+			return
+		}
+	}
+
+	data.ID = state.ID
+	data.ShopperID = state.ShopperID
+	data.CustomerID = state.CustomerID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubaccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shopperID := data.ID.ValueString()
+	if data.AuditClientIP.ValueString() == "" {
+		// Imported sub-accounts have no audit_client_ip until the next apply
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audit_client_ip"),
+			"Missing Audit Client IP",
+			fmt.Sprintf("GoDaddy requires an audit client IP to delete sub-account %s, but none is recorded in the state. "+
+				"Apply the configuration once to record audit_client_ip, then destroy the sub-account.", shopperID),
+		)
+		return
+	}
+	if err := r.client.DeleteShopper(ctx, shopperID, data.AuditClientIP.ValueString()); err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Sub-Account",
+			fmt.Sprintf("Could not delete sub-account %s: %s", shopperID, err),
+		)
+	}
+}
+
+func (r *SubaccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
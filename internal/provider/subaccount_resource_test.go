@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeShopperServer serves sub-account 123456 and records what is sent to
+// it. With gone set, the sub-account no longer exists.
+type fakeShopperServer struct {
+	gone      bool
+	created   *godaddy.SubaccountCreate
+	updated   *godaddy.ShopperUpdate
+	passwords []string
+	deletedBy string
+}
+
+func (f *fakeShopperServer) start(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if f.gone && r.URL.Path != "/v1/shoppers/subaccount" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/shoppers/subaccount":
+			f.created = &godaddy.SubaccountCreate{}
+			json.NewDecoder(r.Body).Decode(f.created)
+			w.Write([]byte(`{"shopperId":"123456","customerId":"cust-9"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/shoppers/123456":
+			w.Write([]byte(`{"shopperId":"123456","customerId":"cust-9","nameFirst":"Jane","nameLast":"Doe",
+				"email":"jane@example.com","externalId":1042,"marketId":"en-GB"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/shoppers/123456":
+			f.updated = &godaddy.ShopperUpdate{}
+			json.NewDecoder(r.Body).Decode(f.updated)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/shoppers/123456/factors/password":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			f.passwords = append(f.passwords, body["secret"])
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/shoppers/123456":
+			f.deletedBy = r.URL.Query().Get("auditClientIp")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func subaccountConfig(password string, passwordVersion int) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"name_first":       tftypes.NewValue(tftypes.String, "Jane"),
+		"name_last":        tftypes.NewValue(tftypes.String, "Doe"),
+		"email":            tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"market_id":        tftypes.NewValue(tftypes.String, "en-GB"),
+		"password":         tftypes.NewValue(tftypes.String, password),
+		"password_version": tftypes.NewValue(tftypes.Number, passwordVersion),
+		"audit_client_ip":  tftypes.NewValue(tftypes.String, "203.0.113.10"),
+	}
+}
+
+func TestSubaccountResource_Create(t *testing.T) {
+	fake := &fakeShopperServer{}
+	r := &SubaccountResource{client: newTestClient(fake.start(t).URL)}
+
+	resp := createResource(t, r, subaccountConfig("s3cret!", 1))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+	}
+
+	// The plan never holds the write-only password, so it must come from the
+	// configuration
+	if fake.created == nil || fake.created.Password != "s3cret!" {
+		t.Fatalf("created = %+v, want the configured password", fake.created)
+	}
+	if fake.created.ExternalID != nil {
+		t.Errorf("external_id = %d, want none when not configured", *fake.created.ExternalID)
+	}
+
+	var data SubaccountResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID.ValueString() != "123456" || data.CustomerID.ValueString() != "cust-9" {
+		t.Errorf("id = %s, customer_id = %s, want 123456 and cust-9", data.ID, data.CustomerID)
+	}
+	if !data.Password.IsNull() {
+		t.Error("password was stored in the state")
+	}
+	if !data.ExternalID.IsNull() {
+		t.Errorf("external_id = %s, want null", data.ExternalID)
+	}
+}
+
+func TestSubaccountResource_UpdatePassword(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       int
+		wantPasswords []string
+	}{
+		{name: "same password_version", version: 1},
+		{name: "new password_version", version: 2, wantPasswords: []string{"n3w-secret"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			fake := &fakeShopperServer{}
+			r := &SubaccountResource{client: newTestClient(fake.start(t).URL)}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":               tftypes.NewValue(tftypes.String, "123456"),
+				"shopper_id":       tftypes.NewValue(tftypes.String, "123456"),
+				"customer_id":      tftypes.NewValue(tftypes.String, "cust-9"),
+				"name_first":       tftypes.NewValue(tftypes.String, "Jane"),
+				"name_last":        tftypes.NewValue(tftypes.String, "Doe"),
+				"email":            tftypes.NewValue(tftypes.String, "jane@example.com"),
+				"market_id":        tftypes.NewValue(tftypes.String, "en-GB"),
+				"external_id":      tftypes.NewValue(tftypes.Number, 1042),
+				"password_version": tftypes.NewValue(tftypes.Number, 1),
+				"audit_client_ip":  tftypes.NewValue(tftypes.String, "203.0.113.10"),
+			})
+			config := subaccountConfig("n3w-secret", tt.version)
+			config["name_last"] = tftypes.NewValue(tftypes.String, "Smith")
+			planned := planResource(t, r, state, config)
+
+			s := resourceSchema(r)
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: s, Raw: newTestObject(t, s.Type(), config)},
+				Plan:   planned.Plan,
+				State:  state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() diagnostics = %v", resp.Diagnostics)
+			}
+
+			if fake.updated == nil || fake.updated.NameLast != "Smith" {
+				t.Errorf("updated = %+v, want the new last name", fake.updated)
+			}
+			if fake.updated != nil && (fake.updated.ExternalID == nil || *fake.updated.ExternalID != 1042) {
+				t.Errorf("updated external_id = %v, want the one in the state kept", fake.updated.ExternalID)
+			}
+			if len(fake.passwords) != len(tt.wantPasswords) || (len(fake.passwords) > 0 && fake.passwords[0] != tt.wantPasswords[0]) {
+				t.Errorf("passwords sent = %v, want %v", fake.passwords, tt.wantPasswords)
+			}
+		})
+	}
+}
+
+func TestSubaccountResource_ImportReadDelete(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeShopperServer{}
+	r := &SubaccountResource{client: newTestClient(fake.start(t).URL)}
+
+	imported := importResource(t, r, "123456")
+	if imported.Diagnostics.HasError() {
+		t.Fatalf("ImportState() diagnostics = %v", imported.Diagnostics)
+	}
+
+	read := readResource(t, r, imported.State)
+	if read.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics = %v", read.Diagnostics)
+	}
+
+	var data SubaccountResourceModel
+	read.Diagnostics.Append(read.State.Get(ctx, &data)...)
+	if data.ShopperID.ValueString() != "123456" || data.CustomerID.ValueString() != "cust-9" ||
+		data.Email.ValueString() != "jane@example.com" || data.MarketID.ValueString() != "en-GB" {
+		t.Errorf("state = %+v, want the sub-account read from GoDaddy", data)
+	}
+	if data.ExternalID.ValueInt64() != 1042 {
+		t.Errorf("external_id = %s, want 1042", data.ExternalID)
+	}
+	if !data.AuditClientIP.IsNull() {
+		t.Errorf("audit_client_ip = %s, want null after import", data.AuditClientIP)
+	}
+
+	// Deleting without an audit client IP must fail rather than do nothing
+	deleted := deleteResource(t, r, read.State)
+	if !deleted.Diagnostics.HasError() {
+		t.Error("Delete() expected an error without audit_client_ip")
+	}
+	if fake.deletedBy != "" {
+		t.Errorf("Delete() called GoDaddy with audit client IP %q", fake.deletedBy)
+	}
+}
+
+func TestSubaccountResource_Delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		gone          bool
+		wantDeletedBy string
+	}{
+		{name: "existing", wantDeletedBy: "203.0.113.10"},
+		{name: "gone", gone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeShopperServer{gone: tt.gone}
+			r := &SubaccountResource{client: newTestClient(fake.start(t).URL)}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":              tftypes.NewValue(tftypes.String, "123456"),
+				"audit_client_ip": tftypes.NewValue(tftypes.String, "203.0.113.10"),
+			})
+			resp := deleteResource(t, r, state)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete() diagnostics = %v", resp.Diagnostics)
+			}
+			if fake.deletedBy != tt.wantDeletedBy {
+				t.Errorf("deleted by %q, want %q", fake.deletedBy, tt.wantDeletedBy)
+			}
+
+			if tt.gone {
+				read := readResource(t, r, state)
+				if read.Diagnostics.HasError() || !read.State.Raw.IsNull() {
+					t.Errorf("Read() = %v, want a sub-account that is gone removed from the state", read.Diagnostics)
+				}
+			}
+		})
+	}
+}
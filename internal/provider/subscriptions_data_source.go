@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SubscriptionsDataSource{}
+
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+type SubscriptionsDataSource struct {
+	client *godaddy.Client
+}
+
+type SubscriptionsDataSourceModel struct {
+	ProductGroupKeys     types.Set  `tfsdk:"product_group_keys"`
+	IncludeRenewalPrices types.Bool `tfsdk:"include_renewal_prices"`
+	Subscriptions        types.List `tfsdk:"subscriptions"`
+}
+
+type SubscriptionModel struct {
+	SubscriptionID    types.String  `tfsdk:"subscription_id"`
+	Label             types.String  `tfsdk:"label"`
+	ProductGroupKey   types.String  `tfsdk:"product_group_key"`
+	Domain            types.String  `tfsdk:"domain"`
+	Status            types.String  `tfsdk:"status"`
+	CreatedAt         types.String  `tfsdk:"created_at"`
+	ExpiresAt         types.String  `tfsdk:"expires_at"`
+	RenewAt           types.String  `tfsdk:"renew_at"`
+	RenewAuto         types.Bool    `tfsdk:"renew_auto"`
+	Renewable         types.Bool    `tfsdk:"renewable"`
+	PriceLocked       types.Bool    `tfsdk:"price_locked"`
+	RenewalPeriod     types.Int64   `tfsdk:"renewal_period"`
+	RenewalPeriodUnit types.String  `tfsdk:"renewal_period_unit"`
+	RenewalPrice      types.Float64 `tfsdk:"renewal_price"`
+	Currency          types.String  `tfsdk:"currency"`
+}
+
+func (d *SubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+func (d *SubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for the subscriptions of the account, with their renewal dates.",
+		Attributes: map[string]schema.Attribute{
+			"product_group_keys": schema.SetAttribute{
+				MarkdownDescription: "Only include subscriptions of these product groups (e.g. `domains`, `ssl`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"include_renewal_prices": schema.BoolAttribute{
+				MarkdownDescription: "Whether to estimate the renewal price of domain subscriptions from the list price of their TLD. Defaults to `false`.",
+				Optional:            true,
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				MarkdownDescription: "The subscriptions, soonest to expire first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subscription_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the subscription.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Display name of the subscription.",
+							Computed:            true,
+						},
+						"product_group_key": schema.StringAttribute{
+							MarkdownDescription: "Product group of the subscription, such as `domains`.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain name of a domain subscription (null for other products).",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the subscription, such as `ACTIVE` or `CANCELED`.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the subscription was created (RFC 3339).",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "When the subscription expires (RFC 3339).",
+							Computed:            true,
+						},
+						"renew_at": schema.StringAttribute{
+							MarkdownDescription: "When the subscription is next billed for renewal (RFC 3339).",
+							Computed:            true,
+						},
+						"renew_auto": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subscription renews automatically.",
+							Computed:            true,
+						},
+						"renewable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subscription can be renewed.",
+							Computed:            true,
+						},
+						"price_locked": schema.BoolAttribute{
+							MarkdownDescription: "Whether the renewal price is locked in.",
+							Computed:            true,
+						},
+						"renewal_period": schema.Int64Attribute{
+							MarkdownDescription: "Length of a renewal, in `renewal_period_unit`.",
+							Computed:            true,
+						},
+						"renewal_period_unit": schema.StringAttribute{
+							MarkdownDescription: "Unit of `renewal_period`, such as `YEAR` or `MONTH`.",
+							Computed:            true,
+						},
+						"renewal_price": schema.Float64Attribute{
+							MarkdownDescription: "Estimated price of one renewal period, in currency units. Only set for domain subscriptions when `include_renewal_prices` is `true`.",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Currency of `renewal_price`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var opts godaddy.SubscriptionListOptions
+	if !data.ProductGroupKeys.IsNull() {
+		resp.Diagnostics.Append(data.ProductGroupKeys.ElementsAs(ctx, &opts.ProductGroupKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	subscriptions, err := d.client.ListSubscriptions(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Subscriptions",
+			fmt.Sprintf("Could not list subscriptions: %s", err),
+		)
+		return
+	}
+
+	quotes := map[string]godaddy.ListPriceQuote{}
+	if data.IncludeRenewalPrices.ValueBool() {
+		var domains []string
+		for _, subscription := range subscriptions {
+			if domain := subscription.Domain(); domain != "" {
+				domains = append(domains, domain)
+			}
+		}
+
+		quotes, err = d.client.GetListPriceQuotes(ctx, domains, 1)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Renewal Prices",
+				fmt.Sprintf("Could not estimate renewal prices for %d domain subscription(s): %s", len(domains), err),
+			)
+			return
+		}
+	}
+
+	models := make([]SubscriptionModel, len(subscriptions))
+	for i, subscription := range subscriptions {
+		model := SubscriptionModel{
+			SubscriptionID:    types.StringValue(subscription.SubscriptionID),
+			Label:             types.StringValue(subscription.Label),
+			ProductGroupKey:   types.StringValue(subscription.Product.ProductGroupKey),
+			Domain:            optionalString(subscription.Domain()),
+			Status:            types.StringValue(subscription.Status),
+			CreatedAt:         optionalTime(subscription.CreatedAt),
+			ExpiresAt:         optionalTime(subscription.ExpiresAt),
+			RenewAt:           optionalTime(subscription.Billing.RenewAt),
+			RenewAuto:         types.BoolValue(subscription.RenewAuto),
+			Renewable:         types.BoolValue(subscription.Renewable),
+			PriceLocked:       types.BoolValue(subscription.PriceLocked),
+			RenewalPeriod:     types.Int64Null(),
+			RenewalPeriodUnit: optionalString(subscription.Product.RenewalPeriodUnit),
+			RenewalPrice:      types.Float64Null(),
+			Currency:          types.StringNull(),
+		}
+		if subscription.Product.RenewalPeriod > 0 {
+			model.RenewalPeriod = types.Int64Value(int64(subscription.Product.RenewalPeriod))
+		}
+
+		// Quotes are yearly; domains always renew in whole years
+		if quote, ok := quotes[subscription.Domain()]; ok {
+			years := subscription.Product.RenewalPeriod
+			if years <= 0 {
+				years = 1
+			}
+			model.RenewalPrice = types.Float64Value(quote.Price * float64(years))
+			model.Currency = optionalString(quote.Currency)
+		}
+
+		models[i] = model
+	}
+
+	subscriptionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: subscriptionAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Subscriptions = subscriptionsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func subscriptionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"subscription_id":     types.StringType,
+		"label":               types.StringType,
+		"product_group_key":   types.StringType,
+		"domain":              types.StringType,
+		"status":              types.StringType,
+		"created_at":          types.StringType,
+		"expires_at":          types.StringType,
+		"renew_at":            types.StringType,
+		"renew_auto":          types.BoolType,
+		"renewable":           types.BoolType,
+		"price_locked":        types.BoolType,
+		"renewal_period":      types.Int64Type,
+		"renewal_period_unit": types.StringType,
+		"renewal_price":       types.Float64Type,
+		"currency":            types.StringType,
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSubscriptionsDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/subscriptions":
+			w.Write([]byte(`{"subscriptions":[
+				{"subscriptionId":"1","label":"EXAMPLE.COM","status":"ACTIVE","renewAuto":true,
+				 "product":{"productGroupKey":"domains","renewalPeriod":2,"renewalPeriodUnit":"YEAR"},
+				 "billing":{"renewAt":"2027-01-01T00:00:00Z"}},
+				{"subscriptionId":"2","label":"Standard SSL","status":"ACTIVE",
+				 "product":{"productGroupKey":"ssl","renewalPeriod":1,"renewalPeriodUnit":"YEAR"}}
+			],"pagination":{"total":2}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/domains/available":
+			w.Write([]byte(`{"domains":[{"domain":"example.com","available":false,"price":10000000,"currency":"USD","period":1}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	resp := readDataSource(t, &SubscriptionsDataSource{client: newTestClient(server.URL)}, map[string]tftypes.Value{
+		"include_renewal_prices": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data SubscriptionsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	var subscriptions []SubscriptionModel
+	resp.Diagnostics.Append(data.Subscriptions.ElementsAs(ctx, &subscriptions, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("expected 2 subscriptions, got %d", len(subscriptions))
+	}
+
+	domain := subscriptions[0]
+	if domain.Domain.ValueString() != "example.com" {
+		t.Errorf("expected domain example.com, got %s", domain.Domain)
+	}
+	if domain.RenewalPrice.ValueFloat64() != 20 || domain.Currency.ValueString() != "USD" {
+		t.Errorf("expected a renewal price of 20 USD for two years, got %s %s", domain.RenewalPrice, domain.Currency)
+	}
+	if domain.RenewAt.ValueString() != "2027-01-01T00:00:00Z" {
+		t.Errorf("expected renew_at 2027-01-01T00:00:00Z, got %s", domain.RenewAt)
+	}
+
+	ssl := subscriptions[1]
+	if !ssl.Domain.IsNull() || !ssl.RenewalPrice.IsNull() {
+		t.Errorf("expected no domain or price for an SSL subscription, got %s %s", ssl.Domain, ssl.RenewalPrice)
+	}
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TLDPurchaseSchemaDataSource{}
+
+func NewTLDPurchaseSchemaDataSource() datasource.DataSource {
+	return &TLDPurchaseSchemaDataSource{}
+}
+
+type TLDPurchaseSchemaDataSource struct {
+	client *godaddy.Client
+}
+
+type TLDPurchaseSchemaDataSourceModel struct {
+	TLD            types.String `tfsdk:"tld"`
+	RequiredFields types.List   `tfsdk:"required_fields"`
+	Fields         types.List   `tfsdk:"fields"`
+	SchemaJSON     types.String `tfsdk:"schema_json"`
+}
+
+type PurchaseSchemaFieldModel struct {
+	Path      types.String  `tfsdk:"path"`
+	Type      types.String  `tfsdk:"type"`
+	ItemType  types.String  `tfsdk:"item_type"`
+	Required  types.Bool    `tfsdk:"required"`
+	Format    types.String  `tfsdk:"format"`
+	Pattern   types.String  `tfsdk:"pattern"`
+	Enum      types.List    `tfsdk:"enum"`
+	Minimum   types.Float64 `tfsdk:"minimum"`
+	Maximum   types.Float64 `tfsdk:"maximum"`
+	MinLength types.Int64   `tfsdk:"min_length"`
+	MaxLength types.Int64   `tfsdk:"max_length"`
+	MinItems  types.Int64   `tfsdk:"min_items"`
+	MaxItems  types.Int64   `tfsdk:"max_items"`
+}
+
+func (d *TLDPurchaseSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tld_purchase_schema"
+}
+
+func (d *TLDPurchaseSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for the fields GoDaddy requires to register a domain with a given TLD.",
+		Attributes: map[string]schema.Attribute{
+			"tld": schema.StringAttribute{
+				MarkdownDescription: "The TLD to get the purchase schema for (e.g. `us`, `ca`).",
+				Required:            true,
+			},
+			"required_fields": schema.ListAttribute{
+				MarkdownDescription: "Paths of the fields that must be set, such as `contactRegistrant.nameFirst`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Every field of the purchase request, sorted by path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Dotted path of the field in the purchase request.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "JSON type of the field, such as `string`, `integer` or `array`.",
+							Computed:            true,
+						},
+						"item_type": schema.StringAttribute{
+							MarkdownDescription: "JSON type of the elements of an `array` field.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field must be set.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Format of the value, such as `email` or `phone`.",
+							Computed:            true,
+						},
+						"pattern": schema.StringAttribute{
+							MarkdownDescription: "Regular expression the value must match.",
+							Computed:            true,
+						},
+						"enum": schema.ListAttribute{
+							MarkdownDescription: "The allowed values.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"minimum": schema.Float64Attribute{
+							MarkdownDescription: "Smallest allowed number.",
+							Computed:            true,
+						},
+						"maximum": schema.Float64Attribute{
+							MarkdownDescription: "Largest allowed number.",
+							Computed:            true,
+						},
+						"min_length": schema.Int64Attribute{
+							MarkdownDescription: "Shortest allowed string.",
+							Computed:            true,
+						},
+						"max_length": schema.Int64Attribute{
+							MarkdownDescription: "Longest allowed string.",
+							Computed:            true,
+						},
+						"min_items": schema.Int64Attribute{
+							MarkdownDescription: "Fewest allowed array elements.",
+							Computed:            true,
+						},
+						"max_items": schema.Int64Attribute{
+							MarkdownDescription: "Most allowed array elements.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"schema_json": schema.StringAttribute{
+				MarkdownDescription: "The complete JSON schema as returned by GoDaddy.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TLDPurchaseSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TLDPurchaseSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TLDPurchaseSchemaDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tld := strings.TrimPrefix(strings.ToLower(data.TLD.ValueString()), ".")
+	purchaseSchema, err := d.client.GetPurchaseSchema(ctx, tld)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Purchase Schema",
+			fmt.Sprintf("Could not get the purchase schema for TLD %s: %s", tld, err),
+		)
+		return
+	}
+
+	required := []string{}
+	var fields []PurchaseSchemaFieldModel
+	for _, field := range purchaseSchema.Fields() {
+		if field.Required {
+			required = append(required, field.Path)
+		}
+
+		model := PurchaseSchemaFieldModel{
+			Path:      types.StringValue(field.Path),
+			Type:      optionalString(field.Type),
+			ItemType:  types.StringNull(),
+			Required:  types.BoolValue(field.Required),
+			Format:    optionalString(field.Format),
+			Pattern:   optionalString(field.Pattern),
+			Enum:      types.ListNull(types.StringType),
+			Minimum:   types.Float64PointerValue(field.Minimum),
+			Maximum:   types.Float64PointerValue(field.Maximum),
+			MinLength: optionalInt64(field.MinLength),
+			MaxLength: optionalInt64(field.MaxLength),
+			MinItems:  optionalInt64(field.MinItems),
+			MaxItems:  optionalInt64(field.MaxItems),
+		}
+		if field.Items != nil {
+			model.ItemType = optionalString(field.Items.Type)
+		}
+		if len(field.Enum) > 0 {
+			enum, diags := types.ListValueFrom(ctx, types.StringType, field.Enum)
+			resp.Diagnostics.Append(diags...)
+			model.Enum = enum
+		}
+		fields = append(fields, model)
+	}
+
+	requiredList, diags := types.ListValueFrom(ctx, types.StringType, required)
+	resp.Diagnostics.Append(diags...)
+	fieldsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: purchaseSchemaFieldAttributeTypes()}, fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RequiredFields = requiredList
+	data.Fields = fieldsList
+	data.SchemaJSON = types.StringValue(purchaseSchema.Raw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func purchaseSchemaFieldAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"path":       types.StringType,
+		"type":       types.StringType,
+		"item_type":  types.StringType,
+		"required":   types.BoolType,
+		"format":     types.StringType,
+		"pattern":    types.StringType,
+		"enum":       types.ListType{ElemType: types.StringType},
+		"minimum":    types.Float64Type,
+		"maximum":    types.Float64Type,
+		"min_length": types.Int64Type,
+		"max_length": types.Int64Type,
+		"min_items":  types.Int64Type,
+		"max_items":  types.Int64Type,
+	}
+}
+
+// optionalInt64 maps an optional API number to a nullable attribute
+func optionalInt64(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bearcode33/terraform-provider-godaddy/internal/godaddy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TLDsDataSource{}
+
+func NewTLDsDataSource() datasource.DataSource {
+	return &TLDsDataSource{}
+}
+
+type TLDsDataSource struct {
+	client *godaddy.Client
+}
+
+type TLDsDataSourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Names types.List   `tfsdk:"names"`
+	TLDs  types.List   `tfsdk:"tlds"`
+}
+
+type TLDModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (d *TLDsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tlds"
+}
+
+func (d *TLDsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for the top-level domains GoDaddy sells.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only include TLDs of this type: `COUNTRY_CODE` or `GENERIC`.",
+				Optional:            true,
+				Validators: []validator.String{
+					StringOneOfValidator(godaddy.TLDTypeCountryCode, godaddy.TLDTypeGeneric),
+				},
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the matching TLDs, sorted alphabetically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tlds": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching TLDs, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The TLD, without a leading dot (e.g. `com`, `co.uk`).",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "`COUNTRY_CODE` or `GENERIC`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TLDsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GoDaddyProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.GoDaddyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TLDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TLDsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tlds, err := d.client.ListTLDs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing TLDs",
+			fmt.Sprintf("Could not list TLDs: %s", err),
+		)
+		return
+	}
+
+	sort.Slice(tlds, func(i, j int) bool { return tlds[i].Name < tlds[j].Name })
+
+	names := []string{}
+	models := []TLDModel{}
+	for _, tld := range tlds {
+		if !data.Type.IsNull() && tld.Type != data.Type.ValueString() {
+			continue
+		}
+		names = append(names, tld.Name)
+		models = append(models, TLDModel{
+			Name: types.StringValue(tld.Name),
+			Type: types.StringValue(tld.Type),
+		})
+	}
+
+	namesList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	tldsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: tldAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Names = namesList
+	data.TLDs = tldsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func tldAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOneOfValidator validates that a string is one of a fixed set of values
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Validates that the value is one of: `%s`", strings.Join(v.values, "`, `"))
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, valid := range v.values {
+		if value == valid {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Value",
+		fmt.Sprintf("%q is not valid. Valid values are: %s", value, strings.Join(v.values, ", ")),
+	)
+}
+
+func StringOneOfValidator(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+// setOfStringOneOfValidator validates that every element of a set of strings
+// is one of a fixed set of values
+type setOfStringOneOfValidator struct {
+	values []string
+}
+
+func (v setOfStringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("each value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v setOfStringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Validates that each value is one of: `%s`", strings.Join(v.values, "`, `"))
+}
+
+func (v setOfStringOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var elements []types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &elements, false)...)
+
+	for _, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+
+		value := element.ValueString()
+		valid := false
+		for _, allowed := range v.values {
+			if value == allowed {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Value",
+				fmt.Sprintf("%q is not valid. Valid values are: %s", value, strings.Join(v.values, ", ")),
+			)
+		}
+	}
+}
+
+func SetOfStringOneOfValidator(values ...string) validator.Set {
+	return setOfStringOneOfValidator{values: values}
+}